@@ -10,13 +10,14 @@
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 05.12.2025
+ * Version of: 18.10.2026
  *
  */
 
 package connect
 
 import (
+	"sort"
 	"strings"
 	"time"
 
@@ -252,23 +253,71 @@ func (e *tModellingBusEventsConnector) messageFromEvent(agentID, topicPath strin
 	return message
 }
 
+// Get the IDs of the agents that currently have a (non-empty) message posted on the given topic path
+func (e *tModellingBusEventsConnector) agentsWithEventsOn(topicPath string) []string {
+	// Getting the topic root and the topic path suffix
+	environmentTopicRoot := e.mqttEnvironmentTopicRoot() + "/"
+	topicPathSuffix := "/" + topicPath
+
+	// Collecting the agent IDs
+	agentIDs := []string{}
+	for topic, message := range e.currentMessages {
+		if len(message) > 0 && strings.HasPrefix(topic, environmentTopicRoot) && strings.HasSuffix(topic, topicPathSuffix) {
+			// The agent ID is what remains between the topic root and the topic path suffix
+			agentID := strings.TrimSuffix(strings.TrimPrefix(topic, environmentTopicRoot), topicPathSuffix)
+			if agentID != "" && !strings.Contains(agentID, "/") {
+				agentIDs = append(agentIDs, agentID)
+			}
+		}
+	}
+
+	// Sort the agent IDs to make the result predictable
+	sort.Strings(agentIDs)
+
+	return agentIDs
+}
+
+// Get the sub-topics of the given topic path of the given agent, that currently have a (non-empty) message posted
+func (e *tModellingBusEventsConnector) subTopicsWithEventsOn(agentID, topicPath string) []string {
+	// Getting the topic path prefix
+	topicPathPrefix := e.mqttAgentTopicPath(agentID, topicPath) + "/"
+
+	// Collecting the sub-topics
+	subTopics := []string{}
+	for topic, message := range e.currentMessages {
+		if len(message) > 0 && strings.HasPrefix(topic, topicPathPrefix) {
+			subTopic := strings.TrimPrefix(topic, topicPathPrefix)
+			if subTopic != "" && !strings.Contains(subTopic, "/") {
+				subTopics = append(subTopics, subTopic)
+			}
+		}
+	}
+
+	// Sort the sub-topics to make the result predictable
+	sort.Strings(subTopics)
+
+	return subTopics
+}
+
 /*
  *  Listening for events
  */
 
-// Listen for events on a given topic path for a given agent
-func (e *tModellingBusEventsConnector) listenForEvents(agentID, topicPath string, eventHandler func([]byte)) {
+// Listen for events on a given topic path for a given agent.
+// The agent ID and topic path may contain MQTT wildcards, which is why the event handler also receives the MQTT topic of the event.
+func (e *tModellingBusEventsConnector) listenForTopicEvents(agentID, topicPath string, eventHandler func(string, []byte)) {
 	// Getting the MQTT topic path
 	mqttTopicPath := e.mqttAgentTopicPath(agentID, topicPath)
 
 	// Setting up the subscription
 	token := e.client.Subscribe(mqttTopicPath, 0, func(client mqtt.Client, msg mqtt.Message) {
-		// Getting the payload
+		// Getting the topic and payload
+		topic := msg.Topic()
 		payload := msg.Payload()
 
 		// Calling the event handler, if necessary
-		if len(payload) > 0 && string(e.openingMessages[mqttTopicPath]) != string(payload) {
-			eventHandler(payload)
+		if len(payload) > 0 && string(e.openingMessages[topic]) != string(payload) {
+			eventHandler(topic, payload)
 		}
 	})
 
@@ -276,6 +325,24 @@ func (e *tModellingBusEventsConnector) listenForEvents(agentID, topicPath string
 	token.Wait()
 }
 
+// Listen for events on a given topic path for a given agent
+func (e *tModellingBusEventsConnector) listenForEvents(agentID, topicPath string, eventHandler func([]byte)) {
+	e.listenForTopicEvents(agentID, topicPath, func(_ string, payload []byte) {
+		eventHandler(payload)
+	})
+}
+
+// Split an MQTT topic of the present modelling environment into the agent ID and the topic path
+func (e *tModellingBusEventsConnector) splitTopic(topic string) (string, string) {
+	// Remove the topic root of the modelling environment
+	agentTopicPath := strings.TrimPrefix(topic, e.mqttEnvironmentTopicRoot()+"/")
+
+	// The agent ID is the first element of what remains
+	agentID, topicPath, _ := strings.Cut(agentTopicPath, "/")
+
+	return agentID, topicPath
+}
+
 /*
  *  Deleting postings
  */
@@ -350,4 +417,7 @@ const (
 	// When creating an events connector only for posting, then use this constant to set this to true
 	// In this case, the connector will not collect existing messages from the bus
 	PostingOnly = true
+
+	// When listening for postings, use this constant as agent ID to listen to the postings of any agent
+	AnyAgent = "+"
 )
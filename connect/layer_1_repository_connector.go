@@ -8,7 +8,7 @@
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 29.11.2025
+ * Version of: 18.10.2026
  *
  */
 
@@ -16,7 +16,9 @@ package connect
 
 import (
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/erikproper/big-modelling-bus.go.v1/generics"
@@ -52,10 +54,19 @@ type (
  */
 
 type tRepositoryEvent struct {
-	Server    string `json:"server,omitempty"`    // FTP server for the file
-	Port      string `json:"port,omitempty"`      // FTP port on the FTP server
-	FilePath  string `json:"file path,omitempty"` // Path to the file on the FTP server
-	Timestamp string `json:"timestamp"`           // Timestamp of the event
+	Server      string `json:"server,omitempty"`       // FTP server for the file
+	Port        string `json:"port,omitempty"`         // FTP port on the FTP server
+	FilePath    string `json:"file path,omitempty"`    // Path to the file on the FTP server
+	Timestamp   string `json:"timestamp"`              // Timestamp of the event
+	PayloadSize int64  `json:"payload size,omitempty"` // Size (in bytes) of the file
+	Digest      string `json:"digest,omitempty"`       // Digest of the file, to verify its integrity
+	ContentHash string `json:"content hash,omitempty"` // For JSON files, the hash of the canonicalised JSON
+}
+
+// The historic versions of a file in the repository
+type tRepositoryVersions struct {
+	event     tRepositoryEvent  // The repository event of the latest version
+	filePaths map[string]string // The file paths of the versions on the FTP server, by their timestamps
 }
 
 /*
@@ -124,10 +135,11 @@ func (r *tModellingBusRepositoryConnector) mkRepositoryFilePath(remoteFilePath s
 }
 
 // Add a file to the repository
+// Each posting is stored under its own timestamped path, so the history of postings is kept in the repository.
 func (r *tModellingBusRepositoryConnector) addFile(topicPath, localFilePath, timestamp string) tRepositoryEvent {
 	// Define the remote file path
 	remoteFilePath := r.ftpTopicPath(topicPath)
-	remotePayloadFileNamePath := remoteFilePath + "/" + generics.PayloadFileName
+	remoteVersionFilePath := remoteFilePath + "/" + timestamp + filepath.Ext(localFilePath)
 
 	// Make sure the path exists on the FTP server
 	r.mkRepositoryFilePath(remoteFilePath)
@@ -136,6 +148,13 @@ func (r *tModellingBusRepositoryConnector) addFile(topicPath, localFilePath, tim
 	repositoryEvent := tRepositoryEvent{}
 	repositoryEvent.Timestamp = timestamp
 
+	// Determine the size and digest of the file, so receivers can verify its integrity
+	payloadSize, digest, err := generics.FileDigest(localFilePath)
+	if err != nil {
+		r.reporter.ReportError("Error computing the digest of the file:", err)
+		return repositoryEvent
+	}
+
 	// Open the local file for reading
 	file, err := os.Open(filepath.FromSlash(localFilePath))
 
@@ -152,12 +171,12 @@ func (r *tModellingBusRepositoryConnector) addFile(topicPath, localFilePath, tim
 	}
 
 	// Store the file on the FTP server
-	err = client.Store(remotePayloadFileNamePath, file)
+	err = client.Store(remoteVersionFilePath, file)
 
 	// Handle potential errors when opening the file
 	if err != nil {
 		r.reporter.ReportError("Error uploading file to ftp server:", err)
-		r.reporter.Error("For remote file path: %s", remotePayloadFileNamePath)
+		r.reporter.Error("For remote file path: %s", remoteVersionFilePath)
 		return repositoryEvent
 	}
 
@@ -169,7 +188,9 @@ func (r *tModellingBusRepositoryConnector) addFile(topicPath, localFilePath, tim
 		repositoryEvent.Server = r.server
 		repositoryEvent.Port = r.port
 	}
-	repositoryEvent.FilePath = remotePayloadFileNamePath
+	repositoryEvent.FilePath = remoteVersionFilePath
+	repositoryEvent.PayloadSize = payloadSize
+	repositoryEvent.Digest = digest
 
 	// Return the repository event
 	return repositoryEvent
@@ -235,11 +256,19 @@ func (r *tModellingBusRepositoryConnector) addJSONAsFile(topicPath string, json
 	defer os.Remove(localFilePath)
 
 	// Add the file to the repository
-	return r.addFile(topicPath, localFilePath, timestamp)
+	repositoryEvent := r.addFile(topicPath, localFilePath, timestamp)
+
+	// Add the hash of the canonicalised JSON, so receivers can verify the content
+	contentHash, err := generics.JSONHash(json)
+	if !r.reporter.MaybeReportError("Error computing the hash of the JSON:", err) {
+		repositoryEvent.ContentHash = contentHash
+	}
+
+	return repositoryEvent
 }
 
-// Get a file from the repository
-func (r *tModellingBusRepositoryConnector) getFile(repositoryEvent tRepositoryEvent, fileName string) string {
+// Connect to the FTP server holding the file referred to by the given repository event
+func (r *tModellingBusRepositoryConnector) ftpConnectFor(repositoryEvent tRepositoryEvent) (*goftp.Client, bool) {
 	// Configure FTP connection
 	config := goftp.Config{}
 	config.ActiveTransfers = r.activeTransfers
@@ -259,9 +288,24 @@ func (r *tModellingBusRepositoryConnector) getFile(repositoryEvent tRepositoryEv
 	client, err := goftp.DialConfig(config, serverConnection)
 	if err != nil {
 		r.reporter.ReportError("Something went wrong connecting to the FTP server:", err)
+		return client, false
+	}
+
+	// Return the connected client
+	return client, true
+}
+
+// Get a file from the repository
+func (r *tModellingBusRepositoryConnector) getFile(repositoryEvent tRepositoryEvent, fileName string) string {
+	// Connect to the FTP server
+	client, ok := r.ftpConnectFor(repositoryEvent)
+	if !ok {
 		return ""
 	}
 
+	// Ensure the FTP connection is closed after operation
+	defer client.Close()
+
 	// Set local file path
 	localFileName := r.localFilePathFor(fileName)
 
@@ -272,20 +316,133 @@ func (r *tModellingBusRepositoryConnector) getFile(repositoryEvent tRepositoryEv
 		return ""
 	}
 
-	// Ensure the file is closed after operation
-	defer File.Close()
-
 	// Retrieve the file from the FTP server
-	if err = client.Retrieve(repositoryEvent.FilePath, File); err != nil {
+	err = client.Retrieve(repositoryEvent.FilePath, File)
+
+	// Close the file before verifying it, as an open file cannot be removed on all platforms
+	File.Close()
+
+	// Handle potential errors
+	if err != nil {
 		r.reporter.ReportError("Something went wrong retrieving file:", err)
 		r.reporter.Error("Was trying to retrieve: %s", repositoryEvent.FilePath)
 		return ""
 	}
 
+	// Verify the integrity of the retrieved file
+	if !r.verifyFile(repositoryEvent, localFileName) {
+		os.Remove(localFileName)
+		return ""
+	}
+
 	// Return the local file name
 	return localFileName
 }
 
+// Verify the size and digest of a retrieved file against the ones in the repository event
+func (r *tModellingBusRepositoryConnector) verifyFile(repositoryEvent tRepositoryEvent, localFileName string) bool {
+	// Events from agents that do not provide a digest cannot be verified
+	if repositoryEvent.Digest == "" {
+		return true
+	}
+
+	// Determine the size and digest of the retrieved file
+	payloadSize, digest, err := generics.FileDigest(localFileName)
+	if r.reporter.MaybeReportError("Something went wrong computing the digest of the retrieved file:", err) {
+		return false
+	}
+
+	// Compare them to the ones in the repository event
+	if payloadSize != repositoryEvent.PayloadSize || digest != repositoryEvent.Digest {
+		r.reporter.Error("The retrieved file does not match its size and digest: %s", repositoryEvent.FilePath)
+		return false
+	}
+
+	return true
+}
+
+/*
+ * Historic versions of postings
+ */
+
+// Get the historic versions of the file referred to by the given repository event.
+// The versions are listed once, so that several of them can be retrieved without listing them again.
+func (r *tModellingBusRepositoryConnector) getFileVersions(repositoryEvent tRepositoryEvent) tRepositoryVersions {
+	fileVersions := tRepositoryVersions{}
+	fileVersions.event = repositoryEvent
+	fileVersions.filePaths = map[string]string{}
+
+	// Without a file path, there are no versions
+	if repositoryEvent.FilePath == "" {
+		return fileVersions
+	}
+
+	// Connect to the FTP server
+	client, ok := r.ftpConnectFor(repositoryEvent)
+	if !ok {
+		return fileVersions
+	}
+
+	// Ensure the FTP connection is closed after operation
+	defer client.Close()
+
+	// All versions are stored in the same folder as the referred file
+	versionsPath := path.Dir(repositoryEvent.FilePath)
+	fileInfos, err := client.ReadDir(versionsPath)
+	if err != nil {
+		r.reporter.ReportError("Something went wrong reading the versions folder:", err)
+		r.reporter.Error("Was trying to read: %s", versionsPath)
+		return fileVersions
+	}
+
+	// The file names are made up from the timestamp and the extension of the posted file
+	for _, fileInfo := range fileInfos {
+		if !fileInfo.IsDir() {
+			fileName := fileInfo.Name()
+			fileVersions.filePaths[strings.TrimSuffix(fileName, path.Ext(fileName))] = versionsPath + "/" + fileName
+		}
+	}
+
+	return fileVersions
+}
+
+// Get the timestamps of the listed historic versions, in chronological order
+func (v tRepositoryVersions) timestamps() []string {
+	timestamps := []string{}
+	for timestamp := range v.filePaths {
+		timestamps = append(timestamps, timestamp)
+	}
+
+	// Timestamps are ordered chronologically, where the counters of timestamps are compared as numbers
+	slices.SortFunc(timestamps, generics.CompareTimestamps)
+
+	return timestamps
+}
+
+// Get a historic version, identified by its timestamp, from the listed historic versions
+func (r *tModellingBusRepositoryConnector) getFileVersion(fileVersions tRepositoryVersions, timestamp, fileName string) string {
+	// Find the file path of the requested version
+	filePath, found := fileVersions.filePaths[timestamp]
+	if !found {
+		r.reporter.Error("No version with timestamp %s found for: %s", timestamp, fileVersions.event.FilePath)
+		return ""
+	}
+
+	// Retrieve the file using a repository event referring to the requested version
+	versionEvent := fileVersions.event
+	versionEvent.FilePath = filePath
+	versionEvent.Timestamp = timestamp
+
+	// The size and digest in the repository event only apply to the latest version
+	if versionEvent.FilePath != fileVersions.event.FilePath {
+		versionEvent.PayloadSize = 0
+		versionEvent.Digest = ""
+		versionEvent.ContentHash = ""
+	}
+
+	return r.getFile(versionEvent, fileName)
+}
+
 // Create the modelling bus repository connector
 func createModellingBusRepositoryConnector(environmentID, agentID string, configData *generics.TConfigData, reporter *generics.TReporter) *tModellingBusRepositoryConnector {
 	// Create the repository connector
@@ -0,0 +1,42 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 1 - Repository Connector (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRepositoryVersionTimestamps(t *testing.T) {
+	versions := tRepositoryVersions{filePaths: map[string]string{}}
+	for _, timestamp := range []string{
+		"2026-10-18-12-00-00-100",
+		"2026-10-18-12-00-01-00",
+		"2026-10-18-12-00-00-99",
+		"2026-10-18-11-59-59-05",
+		"2026-10-18-12-00-00-09",
+	} {
+		versions.filePaths[timestamp] = "versions/" + timestamp + ".json"
+	}
+
+	want := []string{
+		"2026-10-18-11-59-59-05",
+		"2026-10-18-12-00-00-09",
+		"2026-10-18-12-00-00-99",
+		"2026-10-18-12-00-00-100",
+		"2026-10-18-12-00-01-00",
+	}
+	if timestamps := versions.timestamps(); !slices.Equal(timestamps, want) {
+		t.Errorf("got %v, want %v", timestamps, want)
+	}
+}
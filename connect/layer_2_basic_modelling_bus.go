@@ -12,7 +12,7 @@
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 12.12.2025
+ * Version of: 18.10.2026
  *
  */
 
@@ -21,6 +21,7 @@ package connect
 import (
 	"encoding/json"
 	"os"
+	"strings"
 
 	"github.com/erikproper/big-modelling-bus.go.v1/generics"
 )
@@ -110,11 +111,11 @@ func (b *TModellingBusConnector) postJSONAsStreamed(topicPath string, jsonMessag
  * Retrieving things
  */
 
-// Get a linked file from the repository, given the message from the modelling bus
-func (b *TModellingBusConnector) getLinkedFileFromRepository(message []byte, localFileName string) (string, string) {
-	// If no message is given, return empty values
+// Get the repository event from a message from the modelling bus
+func (b *TModellingBusConnector) repositoryEventFromMessage(message []byte) (tRepositoryEvent, bool) {
+	// If no message is given, return an empty event
 	if len(message) == 0 {
-		return "", ""
+		return tRepositoryEvent{}, false
 	}
 
 	// Unmarshal the message to get the repository event
@@ -123,6 +124,17 @@ func (b *TModellingBusConnector) getLinkedFileFromRepository(message []byte, loc
 
 	// Handle potential errors
 	if b.Reporter.MaybeReportError("Something went wrong unmarshalling the repository event:", err) {
+		return tRepositoryEvent{}, false
+	}
+
+	return event, true
+}
+
+// Get a linked file from the repository, given the message from the modelling bus
+func (b *TModellingBusConnector) getLinkedFileFromRepository(message []byte, localFileName string) (string, string) {
+	// Get the repository event from the message
+	event, ok := b.repositoryEventFromMessage(message)
+	if !ok {
 		return "", ""
 	}
 
@@ -152,10 +164,16 @@ func (b *TModellingBusConnector) getJSONFromTemporaryFile(tempFilePath, timestam
 	return jsonPayload, timestamp
 }
 
-// Get JSON from the repository, given a posting on the modelling bus
-func (b *TModellingBusConnector) getJSON(agentID, topicPath string) ([]byte, string) {
+// Get a linked JSON from the repository, given the message from the modelling bus
+func (b *TModellingBusConnector) getLinkedJSONFromRepository(message []byte) ([]byte, string) {
+	// Get the repository event from the message
+	event, ok := b.repositoryEventFromMessage(message)
+	if !ok {
+		return []byte{}, ""
+	}
+
 	// Get the linked file from the repository
-	tempFilePath, timestamp := b.getLinkedFileFromRepository(b.modellingBusEventsConnector.messageFromEvent(agentID, topicPath), generics.JSONFileName)
+	tempFilePath := b.modellingBusRepositoryConnector.getFile(event, generics.JSONFileName)
 
 	// Read the JSON payload from the temporary file
 	jsonPayload, err := os.ReadFile(tempFilePath)
@@ -166,8 +184,67 @@ func (b *TModellingBusConnector) getJSON(agentID, topicPath string) ([]byte, str
 		return []byte{}, ""
 	}
 
+	// Verify the hash of the canonicalised JSON, when provided
+	if event.ContentHash != "" {
+		contentHash, err := generics.JSONHash(jsonPayload)
+		if b.Reporter.MaybeReportError("Something went wrong computing the hash of the retrieved JSON:", err) {
+			return []byte{}, ""
+		}
+
+		if contentHash != event.ContentHash {
+			b.Reporter.Error("The retrieved JSON does not match its hash: %s", event.FilePath)
+			return []byte{}, ""
+		}
+	}
+
 	// Return the JSON payload and timestamp
-	return jsonPayload, timestamp
+	return jsonPayload, event.Timestamp
+}
+
+// Get JSON from the repository, given a posting on the modelling bus
+func (b *TModellingBusConnector) getJSON(agentID, topicPath string) ([]byte, string) {
+	return b.getLinkedJSONFromRepository(b.modellingBusEventsConnector.messageFromEvent(agentID, topicPath))
+}
+
+// List the historic versions of a posting on the modelling bus
+func (b *TModellingBusConnector) listPostingVersions(agentID, topicPath string) tRepositoryVersions {
+	// Get the repository event of the latest posting
+	event, ok := b.repositoryEventFromMessage(b.modellingBusEventsConnector.messageFromEvent(agentID, topicPath))
+	if !ok {
+		return tRepositoryVersions{event: event, filePaths: map[string]string{}}
+	}
+
+	// List the versions stored alongside the latest posting
+	return b.modellingBusRepositoryConnector.getFileVersions(event)
+}
+
+// Get the timestamps of the historic versions of a posting on the modelling bus, in chronological order
+func (b *TModellingBusConnector) getPostingVersions(agentID, topicPath string) []string {
+	return b.listPostingVersions(agentID, topicPath).timestamps()
+}
+
+// Get a historic version, identified by its timestamp, of a linked file from a posting on the modelling bus
+func (b *TModellingBusConnector) getFileVersionFromPosting(agentID, topicPath, timestamp, localFileName string) string {
+	return b.modellingBusRepositoryConnector.getFileVersion(b.listPostingVersions(agentID, topicPath), timestamp, localFileName)
+}
+
+// Get a historic version, identified by its timestamp, of a JSON posting on the modelling bus
+func (b *TModellingBusConnector) getJSONVersion(agentID, topicPath, timestamp string) ([]byte, bool) {
+	return b.getJSONVersionFrom(b.listPostingVersions(agentID, topicPath), timestamp)
+}
+
+// Get a historic version, identified by its timestamp, from the already listed versions of a JSON posting
+func (b *TModellingBusConnector) getJSONVersionFrom(postingVersions tRepositoryVersions, timestamp string) ([]byte, bool) {
+	// Get the requested version from the repository
+	tempFilePath := b.modellingBusRepositoryConnector.getFileVersion(postingVersions, timestamp, generics.JSONFileName)
+	if tempFilePath == "" {
+		return []byte{}, false
+	}
+
+	// Read the JSON payload from the temporary file
+	jsonPayload, _ := b.getJSONFromTemporaryFile(tempFilePath, timestamp)
+
+	return jsonPayload, len(jsonPayload) > 0
 }
 
 // Split a streamed event from the message into Payload and Timestamp
@@ -206,7 +283,27 @@ func (b *TModellingBusConnector) listenForFilePostings(agentID, topicPath, local
 func (b *TModellingBusConnector) listenForJSONFilePostings(agentID, topicPath string, postingHandler func([]byte, string)) {
 	// Listen for JSON file related events on the modelling bus
 	b.modellingBusEventsConnector.listenForEvents(agentID, topicPath, func(message []byte) {
-		postingHandler(b.getJSONFromTemporaryFile(b.getLinkedFileFromRepository(message, generics.JSONFileName)))
+		postingHandler(b.getLinkedJSONFromRepository(message))
+	})
+}
+
+// Listen for JSON file postings on the modelling bus from any agent, where the posting handler also receives the posting agent's ID
+func (b *TModellingBusConnector) listenForJSONFilePostingsFromAnyAgent(topicPath string, postingHandler func(string, []byte, string)) {
+	// Listen for JSON file related events on the modelling bus
+	b.modellingBusEventsConnector.listenForTopicEvents(AnyAgent, topicPath, func(topic string, message []byte) {
+		agentID, _ := b.modellingBusEventsConnector.splitTopic(topic)
+		jsonPayload, timestamp := b.getLinkedJSONFromRepository(message)
+		postingHandler(agentID, jsonPayload, timestamp)
+	})
+}
+
+// Listen for JSON file postings on the sub-topics of a given topic path, where the posting handler also receives the sub-topic
+func (b *TModellingBusConnector) listenForJSONFilePostingsOnSubTopics(agentID, topicPath string, postingHandler func(string, []byte, string)) {
+	// Listen for JSON file related events on the modelling bus
+	b.modellingBusEventsConnector.listenForTopicEvents(agentID, topicPath+"/+", func(topic string, message []byte) {
+		_, postingTopicPath := b.modellingBusEventsConnector.splitTopic(topic)
+		jsonPayload, timestamp := b.getLinkedJSONFromRepository(message)
+		postingHandler(strings.TrimPrefix(postingTopicPath, topicPath+"/"), jsonPayload, timestamp)
 	})
 }
 
@@ -218,6 +315,16 @@ func (b *TModellingBusConnector) listenForStreamedPostings(agentID, topicPath st
 	})
 }
 
+// Listen for streamed postings on the modelling bus from any agent, where the posting handler also receives the posting agent's ID
+func (b *TModellingBusConnector) listenForStreamedPostingsFromAnyAgent(topicPath string, postingHandler func(string, []byte, string)) {
+	// Listen for streamed events on the modelling bus
+	b.modellingBusEventsConnector.listenForTopicEvents(AnyAgent, topicPath, func(topic string, message []byte) {
+		agentID, _ := b.modellingBusEventsConnector.splitTopic(topic)
+		payload, timestamp := b.splitStreamedEventFromMessage(message)
+		postingHandler(agentID, payload, timestamp)
+	})
+}
+
 /*
  * Deleting postings
  */
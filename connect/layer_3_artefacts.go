@@ -8,7 +8,7 @@
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 05.12.2025
+ * Version of: 18.10.2026
  *
  */
 
@@ -16,6 +16,8 @@ package connect
 
 import (
 	"encoding/json"
+	"fmt"
+	"sync"
 
 	"github.com/erikproper/big-modelling-bus.go.v1/generics"
 )
@@ -31,6 +33,9 @@ const (
 	artefactStatePathElement       = "state"       // Artefact state path element
 	artefactConsideringPathElement = "considering" // Artefact considering path element
 	artefactUpdatePathElement      = "update"      // Artefact update path element
+
+	JSONDeltaFormatPatch      = "json patch"       // Deltas as JSON Patch (https://datatracker.ietf.org/doc/html/rfc6902), the default
+	JSONDeltaFormatMergePatch = "json merge patch" // Deltas as JSON Merge Patch (https://datatracker.ietf.org/doc/html/rfc7396)
 )
 
 /*
@@ -50,9 +55,51 @@ type (
 		UpdatedContent    json.RawMessage `json:"-"`                  // The updated content of the artefact
 		ConsideredContent json.RawMessage `json:"-"`                  // The considered content of the artefact
 
+		AlternativeContents   map[string]json.RawMessage `json:"-"` // The contents of the named alternatives for the considered content
+		AlternativeTimestamps map[string]string          `json:"-"` // The timestamps of the named alternatives
+
+		UpdateAction      string `json:"-"` // The action resulting in the updated content, as received by a listener
+		ConsideringAction string `json:"-"` // The action resulting in the considered content, as received by a listener
+
 		// Before we can communicate updates or considering postings, we must have
 		// communicated the state of the model first
 		stateCommunicated bool `json:"-"` // Identifies whether the state has been communicated
+
+		// By default, updates are posted as a cumulative delta against the current state.
+		// In chained mode, each update is posted as a delta against the previous update, where the
+		// state is re-based after a maximum number of updates, or when a delta becomes too large.
+		chainedUpdates       bool `json:"-"` // Whether updates are posted as chained deltas
+		maxChainedUpdates    int  `json:"-"` // Maximum number of chained updates before re-basing the state (0 means no maximum)
+		maxChainedDeltaSize  int  `json:"-"` // Maximum size (in bytes) of a chained delta before re-basing the state (0 means no maximum)
+		chainedUpdatesPosted int  `json:"-"` // The number of chained updates posted since the last state
+
+		// The format of the posted deltas
+		deltaFormat string `json:"-"` // The format of the posted deltas (JSONDeltaFormatPatch or JSONDeltaFormatMergePatch)
+
+		// When listening, postings may not be applicable to the known state of the artefact.
+		// In that case, the listener is out of sync, and will try to re-synchronise with the bus.
+		outOfSync        bool                  `json:"-"` // Whether the listener is out of sync with the bus
+		syncProblem      string                `json:"-"` // The reason why the latest posting could not be applied
+		outOfSyncHandler func(TOutOfSyncEvent) `json:"-"` // The handler to be called when the listener got out of sync
+
+		// Deltas refer to the state they are against by its timestamp, as given by the poster.
+		// Only once a state has been received, the current timestamp is the timestamp of such a state.
+		stateReceived bool `json:"-"` // Whether a state has been received (or retrieved) from the bus
+
+		// Content is validated against the JSON schema registered for the JSON version (if any)
+		validationErrors []generics.TJSONValidationError `json:"-"` // The validation errors of the latest validated content
+
+		// In migrating mode, the connector communicates on the bus in another JSON version
+		busConnector *TModellingBusArtefactConnector `json:"-"` // The connector for the JSON version of the bus (nil when not migrating)
+
+		// For multi-writer artefacts, the owner keeps track of the proposals from other agents
+		// As proposals are received by the MQTT client, while being decided upon by the owner, access is guarded by a mutex.
+		pendingProposals      map[string]TJSONArtefactProposal `json:"-"` // The proposals not yet decided upon
+		pendingProposalsMutex *sync.Mutex                      `json:"-"` // The mutex guarding the pending proposals
+
+		// To support undo and redo, the poster keeps track of the posted updates and considerings
+		undoStack []tJSONArtefactChange `json:"-"` // The changes that can be undone, most recent last
+		redoStack []tJSONArtefactChange `json:"-"` // The changes that can be redone, most recently undone last
 	}
 )
 
@@ -97,19 +144,68 @@ func (b *TModellingBusArtefactConnector) jsonArtefactsConsideringTopicPath(artef
 
 // Defining JSON delta
 type TJSONDelta struct {
-	Operations       json.RawMessage `json:"operations"`        // The JSON delta operations
-	Timestamp        string          `json:"timestamp"`         // Timestamp of the delta
-	CurrentTimestamp string          `json:"current timestamp"` // The current timestamp at the sender side
+	Operations           json.RawMessage `json:"operations"`                      // The JSON delta operations
+	Format               string          `json:"format,omitempty"`                // The format of the operations; empty means JSONDeltaFormatPatch
+	Timestamp            string          `json:"timestamp"`                       // Timestamp of the delta
+	CurrentTimestamp     string          `json:"current timestamp"`               // The current timestamp at the sender side
+	PredecessorTimestamp string          `json:"predecessor timestamp,omitempty"` // For chained deltas, the timestamp of the posting the delta is against
+	ResultHash           string          `json:"result hash,omitempty"`           // The hash of the canonicalised JSON resulting from applying the delta
+	Action               string          `json:"action,omitempty"`                // The action (commit or discard) the delta results from; empty for regular changes
 }
 
-// Posting JSON delta
-func (b *TModellingBusArtefactConnector) postJSONDelta(deltaTopicPath string, oldStateJSON, newStateJSON []byte) {
-	// Create the delta
-	deltaOperationsJSON, err := generics.JSONDiff(oldStateJSON, newStateJSON)
+// Applying the operations of the delta to a given JSON, according to the format of the delta
+func (d TJSONDelta) Apply(baseJSON []byte) (json.RawMessage, error) {
+	switch d.Format {
+	case JSONDeltaFormatPatch, "":
+		return generics.JSONApplyPatch(baseJSON, d.Operations)
+	case JSONDeltaFormatMergePatch:
+		return generics.JSONApplyMergePatch(baseJSON, d.Operations)
+	default:
+		return nil, fmt.Errorf("unknown delta format %s", d.Format)
+	}
+}
+
+// Checking whether the delta results from committing or discarding a layer, rather than from a regular change
+func (d TJSONDelta) IsAction() bool {
+	return d.Action != JSONArtefactActionChange
+}
+
+// Checking whether the delta is chained, i.e. against its predecessor rather than against the current state
+func (d TJSONDelta) IsChained() bool {
+	return d.PredecessorTimestamp != ""
+}
+
+// Creating a JSON delta
+func (b *TModellingBusArtefactConnector) createJSONDelta(oldStateJSON, newStateJSON []byte) (TJSONDelta, bool) {
+	// Create the delta, in the selected format
+	deltaFormat := b.deltaFormat
+	if deltaFormat == "" {
+		deltaFormat = JSONDeltaFormatPatch
+	}
+
+	// Merge patches cannot set values to null, so fall back to a JSON Patch when the new state contains nulls
+	if deltaFormat == JSONDeltaFormatMergePatch && generics.JSONContainsNull(newStateJSON) {
+		deltaFormat = JSONDeltaFormatPatch
+	}
+	var deltaOperationsJSON json.RawMessage
+	var err error
+	if deltaFormat == JSONDeltaFormatMergePatch {
+		deltaOperationsJSON, err = generics.JSONMergeDiff(oldStateJSON, newStateJSON)
+	} else {
+		deltaOperationsJSON, err = generics.JSONDiff(oldStateJSON, newStateJSON)
+	}
 
 	// Handle potential errors
 	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong running the JSON diff:", err) {
-		return
+		return TJSONDelta{}, false
+	}
+
+	// Compute the hash of the resulting JSON, so listeners can verify the result of applying the delta
+	resultHash, err := generics.JSONHash(newStateJSON)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong computing the hash of the JSON:", err) {
+		return TJSONDelta{}, false
 	}
 
 	// Create the delta object
@@ -117,7 +213,14 @@ func (b *TModellingBusArtefactConnector) postJSONDelta(deltaTopicPath string, ol
 	delta.Timestamp = generics.GetTimestamp()
 	delta.CurrentTimestamp = b.CurrentTimestamp
 	delta.Operations = deltaOperationsJSON
+	delta.Format = deltaFormat
+	delta.ResultHash = resultHash
+
+	return delta, true
+}
 
+// Posting a JSON delta object
+func (b *TModellingBusArtefactConnector) postJSONDeltaObject(deltaTopicPath string, delta TJSONDelta) {
 	// Convert the delta to JSON
 	deltaJSON, err := json.Marshal(delta)
 
@@ -125,33 +228,88 @@ func (b *TModellingBusArtefactConnector) postJSONDelta(deltaTopicPath string, ol
 	b.ModellingBusConnector.maybePostJSONAsFile(deltaTopicPath, deltaJSON, delta.Timestamp, "Something went wrong JSONing the diff patch:", err)
 }
 
-// Applying a JSON delta to a given current JSON state
-func (b *TModellingBusArtefactConnector) applyJSONDelta(currentJSONState json.RawMessage, deltaJSON []byte) (json.RawMessage, string, bool) {
+// Posting JSON delta, returning the timestamp of the posted delta
+func (b *TModellingBusArtefactConnector) postJSONDelta(deltaTopicPath string, oldStateJSON, newStateJSON []byte, predecessorTimestamp string) (string, bool) {
+	// Create the delta
+	delta, ok := b.createJSONDelta(oldStateJSON, newStateJSON)
+	if !ok {
+		return "", false
+	}
+	delta.PredecessorTimestamp = predecessorTimestamp
+
+	// Post the delta
+	b.postJSONDeltaObject(deltaTopicPath, delta)
+
+	return delta.Timestamp, true
+}
+
+// Converting a received JSON delta to a delta object
+func (b *TModellingBusArtefactConnector) jsonDeltaFromJSON(deltaJSON []byte) (TJSONDelta, bool) {
 	// Unmarshal the delta
 	delta := TJSONDelta{}
 	err := json.Unmarshal(deltaJSON, &delta)
 
 	// Handle potential errors
 	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong unJSONing the received diff patch:", err) {
-		return currentJSONState, b.CurrentTimestamp, false
+		b.syncProblem = "the received delta could not be read"
+		return TJSONDelta{}, false
 	}
 
+	return delta, true
+}
+
+// Checking whether a received JSON delta is a chained delta
+func (b *TModellingBusArtefactConnector) isChainedJSONDelta(deltaJSON []byte) bool {
+	if len(deltaJSON) == 0 {
+		return false
+	}
+
+	delta, ok := b.jsonDeltaFromJSON(deltaJSON)
+
+	return ok && delta.IsChained()
+}
+
+// Checking whether a received JSON delta is superseded by the state we received, i.e. it is against an earlier state.
+// Without a received state, e.g. when joining after the state was posted, a delta cannot be considered superseded.
+func (b *TModellingBusArtefactConnector) isSupersededJSONDelta(delta TJSONDelta) bool {
+	return b.stateReceived && generics.CompareTimestamps(delta.CurrentTimestamp, b.CurrentTimestamp) < 0
+}
+
+// Applying a JSON delta to a given JSON state
+func (b *TModellingBusArtefactConnector) applyJSONDelta(baseJSONState json.RawMessage, delta TJSONDelta) (json.RawMessage, bool) {
 	// Check whether the delta can be applied
 	if delta.CurrentTimestamp != b.CurrentTimestamp {
 		// When the timestamps don't match, we cannot apply the delta
-		return currentJSONState, b.CurrentTimestamp, false
+		b.syncProblem = "the delta is against state " + delta.CurrentTimestamp + ", while the known state is " + b.CurrentTimestamp
+		return baseJSONState, false
 	}
 
 	// Apply the delta
-	newJSONState, err := generics.JSONApplyPatch(currentJSONState, delta.Operations)
+	newJSONState, err := delta.Apply(baseJSONState)
 
 	// Handle potential errors
 	if b.ModellingBusConnector.Reporter.MaybeReportError("Applying the diff patch did not work:", err) {
-		return currentJSONState, b.CurrentTimestamp, false
+		b.syncProblem = "the delta could not be applied"
+		return baseJSONState, false
+	}
+
+	// Verify the result against the hash provided by the poster, if any
+	if delta.ResultHash != "" {
+		resultHash, err := generics.JSONHash(newJSONState)
+		if err != nil || resultHash != delta.ResultHash {
+			b.syncProblem = "the result of applying the delta does not match its hash"
+			return baseJSONState, false
+		}
+	}
+
+	// Validate the result against the JSON schema
+	if !b.validJSONArtefactContent(newJSONState) {
+		b.syncProblem = "the result of applying the delta does not conform to the JSON schema"
+		return baseJSONState, false
 	}
 
 	// Return the new state
-	return newJSONState, delta.Timestamp, true
+	return newJSONState, true
 }
 
 // Updating the current JSON artefact state
@@ -164,11 +322,21 @@ func (b *TModellingBusArtefactConnector) updateCurrentJSONArtefact(json []byte,
 	b.CurrentTimestamp = currentTimestamp
 	b.UpdatedTimestamp = currentTimestamp
 	b.ConsideredTimestamp = currentTimestamp
+	b.stateReceived = len(json) > 0
+
+	// Alternatives against the previous state are no longer applicable
+	b.clearJSONArtefactAlternatives()
+
+	// A new state has no update or considering yet
+	b.UpdateAction = JSONArtefactActionChange
+	b.ConsideringAction = JSONArtefactActionChange
 }
 
 // Updating the updated JSON artefact state
 func (b *TModellingBusArtefactConnector) updateUpdatedJSONArtefact(json []byte, _ string) bool {
-	// If the json is empty, then the updated state, and considered state, are the same as the current state
+	b.validationErrors = []generics.TJSONValidationError{}
+
+	// If the json is empty, i.e. the update is missing, then the updated state, and considered state, are the same as the current state
 	if len(json) == 0 {
 		b.UpdatedContent = b.CurrentContent
 		b.ConsideredContent = b.CurrentContent
@@ -176,15 +344,63 @@ func (b *TModellingBusArtefactConnector) updateUpdatedJSONArtefact(json []byte,
 		b.UpdatedTimestamp = b.CurrentTimestamp
 		b.ConsideredTimestamp = b.CurrentTimestamp
 
+		b.UpdateAction = JSONArtefactActionMissing
+		b.ConsideringAction = JSONArtefactActionMissing
+
 		return true
 	}
 
-	// Apply the delta to the current content
-	ok := false
-	b.UpdatedContent, b.UpdatedTimestamp, ok = b.applyJSONDelta(b.CurrentContent, json)
+	// Unmarshal the delta
+	delta, ok := b.jsonDeltaFromJSON(json)
+	if !ok {
+		return false
+	}
+
+	// An update against an earlier state has been superseded by the state, and is treated as absent
+	if b.isSupersededJSONDelta(delta) {
+		b.UpdatedContent = b.CurrentContent
+		b.ConsideredContent = b.CurrentContent
+
+		b.UpdatedTimestamp = b.CurrentTimestamp
+		b.ConsideredTimestamp = b.CurrentTimestamp
+
+		b.UpdateAction = JSONArtefactActionChange
+		b.ConsideringAction = JSONArtefactActionChange
+
+		return true
+	}
+
+	// An update against another state than the one received, e.g. when joining after the state was posted, cannot be applied
+	if delta.CurrentTimestamp != b.CurrentTimestamp {
+		b.syncProblem = "the state " + delta.CurrentTimestamp + " the update is against was not received"
+		return false
+	}
+
+	// A cumulative delta is against the current content, while a chained delta is against the
+	// previous update, in which case we must not have missed that update.
+	// Committing or discarding the update always results in the current content.
+	baseContent := b.CurrentContent
+	if delta.IsChained() && !delta.IsAction() {
+		if delta.PredecessorTimestamp != b.UpdatedTimestamp {
+			b.syncProblem = "the update against " + delta.PredecessorTimestamp + " was missed"
+			return false
+		}
+		baseContent = b.UpdatedContent
+	}
+
+	// Apply the delta to the base content
+	updatedContent, ok := b.applyJSONDelta(baseContent, delta)
 	if ok {
+		b.UpdatedContent = updatedContent
+		b.UpdatedTimestamp = delta.Timestamp
 		b.ConsideredContent = b.UpdatedContent
 		b.ConsideredTimestamp = b.UpdatedTimestamp
+
+		b.UpdateAction = delta.Action
+		b.ConsideringAction = delta.Action
+
+		// Alternatives against the previous update are no longer applicable
+		b.clearJSONArtefactAlternatives()
 	}
 
 	// Return whether the update was successful
@@ -193,22 +409,208 @@ func (b *TModellingBusArtefactConnector) updateUpdatedJSONArtefact(json []byte,
 
 // Updating the considered JSON artefact state
 func (b *TModellingBusArtefactConnector) updateConsideringJSONArtefact(json []byte, _ string) bool {
-	// If the json is empty, then the considered state is the same as the updated state
+	b.validationErrors = []generics.TJSONValidationError{}
+
+	// If the json is empty, i.e. the considering is missing, then the considered state is the same as the updated state
 	if len(json) == 0 {
 		b.ConsideredContent = b.UpdatedContent
 		b.ConsideredTimestamp = b.UpdatedTimestamp
 
+		b.ConsideringAction = JSONArtefactActionMissing
+
 		return true
 	}
 
+	// Unmarshal the delta
+	delta, ok := b.jsonDeltaFromJSON(json)
+	if !ok {
+		return false
+	}
+
+	// A considering against an earlier state has been superseded by the state, and is treated as absent
+	if b.isSupersededJSONDelta(delta) {
+		b.ConsideredContent = b.UpdatedContent
+		b.ConsideredTimestamp = b.UpdatedTimestamp
+
+		b.ConsideringAction = JSONArtefactActionChange
+
+		return true
+	}
+
+	// A considering against another state than the one received, e.g. when joining after the state was posted, cannot be applied
+	if delta.CurrentTimestamp != b.CurrentTimestamp {
+		b.syncProblem = "the state " + delta.CurrentTimestamp + " the considering is against was not received"
+		return false
+	}
+
+	// A chained delta must be against the update we know of, unless it commits or discards the considering
+	if delta.IsChained() && !delta.IsAction() && delta.PredecessorTimestamp != b.UpdatedTimestamp {
+		b.syncProblem = "the update against " + delta.PredecessorTimestamp + " was missed"
+		return false
+	}
+
 	// Apply the delta to the updated content
-	ok := false
-	b.ConsideredContent, b.ConsideredTimestamp, ok = b.applyJSONDelta(b.UpdatedContent, json)
+	consideredContent, ok := b.applyJSONDelta(b.UpdatedContent, delta)
+	if ok {
+		b.ConsideredContent = consideredContent
+		b.ConsideredTimestamp = delta.Timestamp
+		b.ConsideringAction = delta.Action
+	}
 
 	// Return whether the update was successful
 	return ok
 }
 
+// Re-synchronising the updated JSON artefact state by replaying the chain of updates from the repository
+func (b *TModellingBusArtefactConnector) replayUpdatedJSONArtefact(agentID, artefactID, untilTimestamp string) bool {
+	// Reconstruct the updated content
+	updatedContent, stateTimestamp, updatedTimestamp, ok := b.reconstructUpdatedJSONArtefact(agentID, artefactID, untilTimestamp)
+
+	// The reconstruction must be based on the state we know of
+	if !ok || stateTimestamp != b.CurrentTimestamp {
+		b.syncProblem = "the chain of updates could not be replayed"
+		return false
+	}
+
+	// Update the updated JSON artefact state
+	b.UpdatedContent = updatedContent
+	b.ConsideredContent = updatedContent
+	b.UpdatedTimestamp = updatedTimestamp
+	b.ConsideredTimestamp = updatedTimestamp
+	b.clearJSONArtefactAlternatives()
+
+	return true
+}
+
+/*
+ * Retrieving JSON artefacts
+ */
+
+// Getting JSON artefact state, returning whether a state was found
+func (b *TModellingBusArtefactConnector) getJSONArtefactState(agentID, artefactID string) bool {
+	// Get the JSON artefact state
+	stateJSON, currentTimestamp := b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsStateTopicPath(artefactID))
+
+	// Update the current JSON artefact state
+	b.updateCurrentJSONArtefact(stateJSON, currentTimestamp)
+
+	return len(stateJSON) > 0
+}
+
+// Getting JSON artefact update, returning whether the update could be applied
+func (b *TModellingBusArtefactConnector) getJSONArtefactUpdate(agentID, artefactID string) bool {
+	// Get the JSON artefact state
+	if !b.getJSONArtefactState(agentID, artefactID) {
+		b.syncProblem = "no state was found"
+		return false
+	}
+
+	// Update the updated JSON artefact state
+	updateJSON, timestamp := b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsUpdateTopicPath(artefactID))
+	if b.updateUpdatedJSONArtefact(updateJSON, timestamp) {
+		return true
+	}
+
+	// When the latest update is part of a chain, replay the chain of updates from the state onwards
+	return b.isChainedJSONDelta(updateJSON) && b.replayUpdatedJSONArtefact(agentID, artefactID, timestamp)
+}
+
+// Getting JSON artefact considering, returning whether the considering could be applied
+func (b *TModellingBusArtefactConnector) getJSONArtefactConsidering(agentID, artefactID string) bool {
+	// Get the JSON artefact update
+	if !b.getJSONArtefactUpdate(agentID, artefactID) {
+		return false
+	}
+
+	// Update the considered JSON artefact state
+	return b.updateConsideringJSONArtefact(b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsConsideringTopicPath(artefactID)))
+}
+
+/*
+ * Reconstructing historic JSON artefacts
+ */
+
+// Selecting the latest of the (chronologically ordered) timestamps that is not after the given timestamp
+func latestTimestampUntil(timestamps []string, timestamp string) (string, bool) {
+	latestTimestamp, found := "", false
+	for _, candidateTimestamp := range timestamps {
+		if generics.CompareTimestamps(candidateTimestamp, timestamp) <= 0 {
+			latestTimestamp, found = candidateTimestamp, true
+		}
+	}
+
+	return latestTimestamp, found
+}
+
+// Reconstructing the updated JSON artefact content as it was at the given timestamp.
+// Besides the content, this returns the timestamp of the used state, and the timestamp of the latest applied posting.
+func (b *TModellingBusArtefactConnector) reconstructUpdatedJSONArtefact(agentID, artefactID, timestamp string) (json.RawMessage, string, string, bool) {
+	// List the historic states and updates once, so that each of them can be retrieved without listing them again
+	stateVersions := b.ModellingBusConnector.listPostingVersions(agentID, b.jsonArtefactsStateTopicPath(artefactID))
+	updateVersions := b.ModellingBusConnector.listPostingVersions(agentID, b.jsonArtefactsUpdateTopicPath(artefactID))
+
+	return b.replayJSONArtefactVersions(artefactID, timestamp, stateVersions, updateVersions, b.ModellingBusConnector.getJSONVersionFrom)
+}
+
+// Replaying the listed historic updates on the nearest listed historic state at, or before, the given timestamp,
+// where getVersion retrieves the content of a listed version.
+// Besides the content, this returns the timestamp of the used state, and the timestamp of the latest applied posting.
+func (b *TModellingBusArtefactConnector) replayJSONArtefactVersions(artefactID, timestamp string, stateVersions, updateVersions tRepositoryVersions, getVersion func(tRepositoryVersions, string) ([]byte, bool)) (json.RawMessage, string, string, bool) {
+	// Find the nearest earlier state
+	stateTimestamp, found := latestTimestampUntil(stateVersions.timestamps(), timestamp)
+	if !found {
+		b.ModellingBusConnector.Reporter.Error("No state of artefact %s found at, or before, %s.", artefactID, timestamp)
+		return []byte{}, "", "", false
+	}
+
+	// Get the content of the state
+	stateContent, ok := getVersion(stateVersions, stateTimestamp)
+	if !ok {
+		return []byte{}, "", "", false
+	}
+
+	// Replay the updates posted between the state and the given timestamp
+	updatedContent, updatedTimestamp := stateContent, stateTimestamp
+	for _, updateTimestamp := range updateVersions.timestamps() {
+		// Only updates between the state and the given timestamp are relevant
+		if generics.CompareTimestamps(updateTimestamp, stateTimestamp) <= 0 || generics.CompareTimestamps(updateTimestamp, timestamp) > 0 {
+			continue
+		}
+
+		// Get the delta of the update
+		deltaJSON, ok := getVersion(updateVersions, updateTimestamp)
+		if !ok {
+			continue
+		}
+		delta, ok := b.jsonDeltaFromJSON(deltaJSON)
+
+		// Only deltas against the used state are relevant
+		if !ok || delta.CurrentTimestamp != stateTimestamp {
+			continue
+		}
+
+		// Cumulative updates are deltas against the state, while chained updates are deltas against their predecessor.
+		// Committing or discarding the update always results in the state.
+		baseContent := stateContent
+		if delta.IsChained() && !delta.IsAction() {
+			if delta.PredecessorTimestamp != updatedTimestamp {
+				b.ModellingBusConnector.Reporter.Error("The chain of updates of artefact %s is broken at %s.", artefactID, updateTimestamp)
+				return []byte{}, "", "", false
+			}
+			baseContent = updatedContent
+		}
+
+		// Apply the delta
+		newContent, err := delta.Apply(baseContent)
+		if b.ModellingBusConnector.Reporter.MaybeReportError("Applying a historic diff patch did not work:", err) {
+			return []byte{}, "", "", false
+		}
+		updatedContent, updatedTimestamp = newContent, updateTimestamp
+	}
+
+	return updatedContent, stateTimestamp, updatedTimestamp, true
+}
+
 /*
  *
  * Externally visible functionality
@@ -227,57 +629,245 @@ func (b *TModellingBusArtefactConnector) PostRawArtefactState(localFilePath stri
 
 // Posting JSON artefact state
 func (b *TModellingBusArtefactConnector) PostJSONArtefactState(stateJSON []byte, okJSONing bool) {
-	// If not ok, then do not proceed
-	if !okJSONing {
+	// If not ok, or not valid, then do not proceed
+	if !okJSONing || b.maybeReportInvalidJSONArtefactContent(stateJSON) {
 		return
 	}
 
+	// In migrating mode, post in the JSON version of the bus
+	if b.busConnector != nil {
+		b.postMigratedJSONArtefact(artefactStatePathElement, stateJSON)
+		return
+	}
+
+	// The changes posted against the previous state can no longer be undone or redone
+	b.undoStack = []tJSONArtefactChange{}
+	b.redoStack = []tJSONArtefactChange{}
+
+	// Post the JSON artefact state
+	b.postJSONArtefactState(stateJSON)
+}
+
+// Posting JSON artefact state, without resetting the undo and redo stacks.
+// This is used when a state is posted as part of posting an update or considering.
+func (b *TModellingBusArtefactConnector) postJSONArtefactState(stateJSON []byte) {
 	// Post the JSON artefact state
 	b.CurrentTimestamp = generics.GetTimestamp()
+	b.UpdatedTimestamp = b.CurrentTimestamp
+	b.ConsideredTimestamp = b.CurrentTimestamp
 	b.CurrentContent = stateJSON
 	b.UpdatedContent = stateJSON
 	b.ConsideredContent = stateJSON
 	b.ModellingBusConnector.postJSONAsFile(b.jsonArtefactsStateTopicPath(b.ArtefactID), b.CurrentContent, b.CurrentTimestamp)
+	b.discardJSONArtefactAlternatives()
 
 	// Mark that the state has been communicated
 	b.stateCommunicated = true
+	b.chainedUpdatesPosted = 0
 }
 
 // Posting JSON artefact update
 func (b *TModellingBusArtefactConnector) PostJSONArtefactUpdate(updatedStateJSON []byte, okJSONing bool) {
-	// If not ok, then do not proceed
-	if !okJSONing {
+	// If not ok, or not valid, then do not proceed
+	if !okJSONing || b.maybeReportInvalidJSONArtefactContent(updatedStateJSON) {
 		return
 	}
 
+	// In migrating mode, post in the JSON version of the bus
+	if b.busConnector != nil {
+		b.postMigratedJSONArtefact(artefactUpdatePathElement, updatedStateJSON)
+		return
+	}
+
+	// Record the change, so it can be undone
+	b.recordJSONArtefactChange(artefactUpdatePathElement, b.UpdatedContent, updatedStateJSON)
+
+	// Post the JSON artefact update
+	b.postJSONArtefactUpdate(updatedStateJSON)
+}
+
+// Posting JSON artefact update, without recording it for undo
+func (b *TModellingBusArtefactConnector) postJSONArtefactUpdate(updatedStateJSON []byte) {
 	// Ensure the state has been communicated
 	if !b.stateCommunicated {
-		b.PostJSONArtefactState(updatedStateJSON, okJSONing)
+		b.postJSONArtefactState(updatedStateJSON)
+	}
+
+	// In chained mode, post a delta against the previous update
+	if b.chainedUpdates {
+		b.postChainedJSONArtefactUpdate(updatedStateJSON)
+
+		return
 	}
 
 	// Post the JSON artefact update
 	b.UpdatedContent = updatedStateJSON
 	b.ConsideredContent = updatedStateJSON
-	b.postJSONDelta(b.jsonArtefactsUpdateTopicPath(b.ArtefactID), b.CurrentContent, b.UpdatedContent)
+	if timestamp, ok := b.postJSONDelta(b.jsonArtefactsUpdateTopicPath(b.ArtefactID), b.CurrentContent, b.UpdatedContent, ""); ok {
+		b.UpdatedTimestamp = timestamp
+		b.ConsideredTimestamp = timestamp
+		b.discardJSONArtefactAlternatives()
+	}
+}
+
+// Checking whether the state should be re-based, rather than posting the given delta as the next chained update
+func (b *TModellingBusArtefactConnector) needsRebasing(delta TJSONDelta) bool {
+	return (b.maxChainedUpdates > 0 && b.chainedUpdatesPosted >= b.maxChainedUpdates) ||
+		(b.maxChainedDeltaSize > 0 && len(delta.Operations) > b.maxChainedDeltaSize)
+}
+
+// Posting JSON artefact update as a chained delta
+func (b *TModellingBusArtefactConnector) postChainedJSONArtefactUpdate(updatedStateJSON []byte) {
+	// Create the delta against the previous update
+	delta, ok := b.createJSONDelta(b.UpdatedContent, updatedStateJSON)
+	if !ok {
+		return
+	}
+	delta.PredecessorTimestamp = b.UpdatedTimestamp
+
+	// Re-base the state when we have posted too many chained updates, or when the delta is too large
+	if b.needsRebasing(delta) {
+		b.ModellingBusConnector.Reporter.Progress(generics.ProgressLevelDetailed, "Re-basing the state of artefact %s.", b.ArtefactID)
+
+		// Post the updated content as the new state.
+		// The updates against the previous state are thereby superseded, so the next update starts a new chain.
+		b.postJSONArtefactState(updatedStateJSON)
+
+		return
+	}
+
+	// Post the JSON artefact update
+	b.chainedUpdatesPosted++
+	b.postJSONDeltaObject(b.jsonArtefactsUpdateTopicPath(b.ArtefactID), delta)
+	b.UpdatedContent = updatedStateJSON
+	b.ConsideredContent = updatedStateJSON
+	b.UpdatedTimestamp = delta.Timestamp
+	b.ConsideredTimestamp = delta.Timestamp
+	b.discardJSONArtefactAlternatives()
 }
 
 // Posting JSON considered artefact
 func (b *TModellingBusArtefactConnector) PostJSONArtefactConsidering(consideringStateJSON []byte, okJSONing bool) {
-	// If not ok, then do not proceed
-	if !okJSONing {
+	// If not ok, or not valid, then do not proceed
+	if !okJSONing || b.maybeReportInvalidJSONArtefactContent(consideringStateJSON) {
+		return
+	}
+
+	// In migrating mode, post in the JSON version of the bus
+	if b.busConnector != nil {
+		b.postMigratedJSONArtefact(artefactConsideringPathElement, consideringStateJSON)
 		return
 	}
 
+	// Record the change, so it can be undone
+	b.recordJSONArtefactChange(artefactConsideringPathElement, b.ConsideredContent, consideringStateJSON)
+
+	// Post the JSON considered artefact
+	b.postJSONArtefactConsidering(consideringStateJSON)
+}
+
+// Posting JSON considered artefact, without recording it for undo
+func (b *TModellingBusArtefactConnector) postJSONArtefactConsidering(consideringStateJSON []byte) {
 	// Ensure the state has been communicated
 	if !b.stateCommunicated {
-		b.PostJSONArtefactState(b.CurrentContent, okJSONing)
+		b.postJSONArtefactState(b.CurrentContent)
 	}
 
 	// Post the JSON considered artefact
 	b.ConsideredContent = consideringStateJSON
 
+	// In chained mode, the considering refers to the update it is against
+	predecessorTimestamp := ""
+	if b.chainedUpdates {
+		predecessorTimestamp = b.UpdatedTimestamp
+	}
+
 	// Post the JSON considered artefact
-	b.postJSONDelta(b.jsonArtefactsConsideringTopicPath(b.ArtefactID), b.UpdatedContent, b.ConsideredContent)
+	if timestamp, ok := b.postJSONDelta(b.jsonArtefactsConsideringTopicPath(b.ArtefactID), b.UpdatedContent, b.ConsideredContent, predecessorTimestamp); ok {
+		b.ConsideredTimestamp = timestamp
+	}
+}
+
+/*
+ * Resuming the posting of artefacts
+ */
+
+// Resuming the posting of a JSON artefact, e.g. after a restart of the posting agent.
+// The state, update, considering and alternatives as previously posted by this agent are adopted from the bus, so that
+// subsequent postings are deltas against them, rather than a new state.
+// Note: this requires the modelling bus connector not to be created in posting only mode.
+// When chained updates are to be used, UseChainedUpdates should be called before resuming.
+func (b *TModellingBusArtefactConnector) ResumeJSONArtefactPosting() bool {
+	// In migrating mode, resume in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().ResumeJSONArtefactPosting() && b.presentMigratedJSONArtefact()
+	}
+
+	// Adopt the state, update and considering as posted by this agent
+	agentID := b.ModellingBusConnector.agentID
+	if !b.getJSONArtefactConsidering(agentID, b.ArtefactID) {
+		b.ModellingBusConnector.Reporter.Progress(generics.ProgressLevelBasic, "Could not resume posting artefact %s, as %s.", b.ArtefactID, b.syncProblem)
+
+		// Start afresh, i.e. with a new state
+		b.updateCurrentJSONArtefact([]byte{}, generics.GetTimestamp())
+		b.stateCommunicated = false
+
+		return false
+	}
+
+	// Adopt the alternatives as posted by this agent, so they are discarded once they are superseded
+	for _, alternativeID := range b.GetJSONArtefactAlternatives(agentID, b.ArtefactID) {
+		b.GetJSONArtefactAlternative(agentID, b.ArtefactID, alternativeID)
+	}
+
+	// Count the chained updates posted since the state, to know when to re-base the state.
+	// As re-basing only posts a new state, all updates posted since the state are part of its chain.
+	b.chainedUpdatesPosted = 0
+	if b.chainedUpdates {
+		for _, updateTimestamp := range b.ListJSONArtefactUpdateVersions(agentID, b.ArtefactID) {
+			if generics.CompareTimestamps(updateTimestamp, b.CurrentTimestamp) > 0 {
+				b.chainedUpdatesPosted++
+			}
+		}
+	}
+
+	// Mark that the state has been communicated
+	b.stateCommunicated = true
+	b.ModellingBusConnector.Reporter.Progress(generics.ProgressLevelBasic, "Resumed posting artefact %s from state %s.", b.ArtefactID, b.CurrentTimestamp)
+
+	return true
+}
+
+/*
+ * Configuring the posting of artefacts
+ */
+
+// Using chained updates, where each update is posted as a delta against the previous update.
+// The state is re-based after maxUpdates chained updates, or when a delta exceeds maxDeltaSize bytes.
+// Using 0 for either of these means that there is no such maximum.
+func (b *TModellingBusArtefactConnector) UseChainedUpdates(maxUpdates, maxDeltaSize int) {
+	b.chainedUpdates = true
+	b.maxChainedUpdates = maxUpdates
+	b.maxChainedDeltaSize = maxDeltaSize
+}
+
+// Using cumulative updates, where each update is posted as a delta against the current state (the default)
+func (b *TModellingBusArtefactConnector) UseCumulativeUpdates() {
+	b.chainedUpdates = false
+}
+
+// Using the given format (JSONDeltaFormatPatch or JSONDeltaFormatMergePatch) for the posted deltas.
+// Listeners support both formats, as the format is recorded in each delta.
+// As merge patches cannot set values to null, deltas resulting in content with nulls are always posted as JSON Patch.
+func (b *TModellingBusArtefactConnector) UseDeltaFormat(deltaFormat string) bool {
+	if deltaFormat != JSONDeltaFormatPatch && deltaFormat != JSONDeltaFormatMergePatch {
+		b.ModellingBusConnector.Reporter.Error("Unknown delta format %s.", deltaFormat)
+		return false
+	}
+
+	b.deltaFormat = deltaFormat
+
+	return true
 }
 
 /*
@@ -294,29 +884,75 @@ func (b *TModellingBusArtefactConnector) ListenForRawArtefactStatePostings(agent
 
 // Listening for JSON artefact state postings
 func (b *TModellingBusArtefactConnector) ListenForJSONArtefactStatePostings(agentID, artefactID string, handler func()) {
+	// In migrating mode, listen in the JSON version of the bus
+	if b.busConnector != nil {
+		b.listenForMigratedJSONArtefactPostings(artefactStatePathElement, agentID, artefactID, handler)
+		return
+	}
+
 	// Listen for JSON artefact state postings
 	b.ModellingBusConnector.listenForJSONFilePostings(agentID, b.jsonArtefactsStateTopicPath(artefactID), func(json []byte, currentTimestamp string) {
-		b.updateCurrentJSONArtefact(json, currentTimestamp)
-		handler()
+		if len(json) > 0 && !b.validJSONArtefactContent(json) {
+			// The state does not conform to the JSON schema
+			b.rejectJSONArtefactPosting(agentID, artefactID, artefactStatePathElement, currentTimestamp)
+		} else if len(json) > 0 {
+			b.updateCurrentJSONArtefact(json, currentTimestamp)
+			b.markInSync()
+			handler()
+		} else {
+			// The state could not be retrieved, or did not pass verification
+			b.syncProblem = "the posted state could not be retrieved"
+			if b.resynchroniseJSONArtefact(agentID, artefactID, artefactStatePathElement) {
+				handler()
+			}
+		}
 	})
 }
 
 // Listening for JSON artefact update postings
 func (b *TModellingBusArtefactConnector) ListenForJSONArtefactUpdatePostings(agentID, artefactID string, handler func()) {
+	// In migrating mode, listen in the JSON version of the bus
+	if b.busConnector != nil {
+		b.listenForMigratedJSONArtefactPostings(artefactUpdatePathElement, agentID, artefactID, handler)
+		return
+	}
+
 	// Listen for JSON artefact update postings
 	b.ModellingBusConnector.listenForJSONFilePostings(agentID, b.jsonArtefactsUpdateTopicPath(artefactID), func(json []byte, timestamp string) {
-		if b.updateUpdatedJSONArtefact(json, timestamp) {
+		switch b.receiveJSONArtefactPosting(b.updateUpdatedJSONArtefact, json, timestamp) {
+		case jsonArtefactPostingApplied:
+			b.markInSync()
 			handler()
+		case jsonArtefactPostingRejected:
+			b.rejectJSONArtefactPosting(agentID, artefactID, artefactUpdatePathElement, timestamp)
+		case jsonArtefactPostingOutOfSync:
+			if b.resynchroniseJSONArtefact(agentID, artefactID, artefactUpdatePathElement) {
+				handler()
+			}
 		}
 	})
 }
 
 // Listening for JSON considered artefact postings
 func (b *TModellingBusArtefactConnector) ListenForJSONArtefactConsideringPostings(agentID, artefactID string, handler func()) {
+	// In migrating mode, listen in the JSON version of the bus
+	if b.busConnector != nil {
+		b.listenForMigratedJSONArtefactPostings(artefactConsideringPathElement, agentID, artefactID, handler)
+		return
+	}
+
 	// Listen for JSON considered artefact postings
 	b.ModellingBusConnector.listenForJSONFilePostings(agentID, b.jsonArtefactsConsideringTopicPath(artefactID), func(json []byte, timestamp string) {
-		if b.updateConsideringJSONArtefact(json, timestamp) {
+		switch b.receiveJSONArtefactPosting(b.updateConsideringJSONArtefact, json, timestamp) {
+		case jsonArtefactPostingApplied:
+			b.markInSync()
 			handler()
+		case jsonArtefactPostingRejected:
+			b.rejectJSONArtefactPosting(agentID, artefactID, artefactConsideringPathElement, timestamp)
+		case jsonArtefactPostingOutOfSync:
+			if b.resynchroniseJSONArtefact(agentID, artefactID, artefactConsideringPathElement) {
+				handler()
+			}
 		}
 	})
 }
@@ -333,26 +969,189 @@ func (b *TModellingBusArtefactConnector) GetRawArtefact(agentID, artefactID, loc
 
 // Getting JSON artefact state
 func (b *TModellingBusArtefactConnector) GetJSONArtefactState(agentID, artefactID string) {
-	// Update the current JSON artefact state
-	b.updateCurrentJSONArtefact(b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsStateTopicPath(artefactID)))
+	// In migrating mode, retrieve in the JSON version of the bus
+	if b.busConnector != nil {
+		b.getMigratedJSONArtefact(artefactStatePathElement, agentID, artefactID)
+		return
+	}
+
+	b.getJSONArtefactState(agentID, artefactID)
 }
 
 // Getting JSON artefact update
 func (b *TModellingBusArtefactConnector) GetJSONArtefactUpdate(agentID, artefactID string) {
-	// Get the JSON artefact update
-	b.GetJSONArtefactState(agentID, artefactID)
+	// In migrating mode, retrieve in the JSON version of the bus
+	if b.busConnector != nil {
+		b.getMigratedJSONArtefact(artefactUpdatePathElement, agentID, artefactID)
+		return
+	}
 
-	// Update the updated JSON artefact state
-	b.updateUpdatedJSONArtefact(b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsUpdateTopicPath(artefactID)))
+	b.getJSONArtefactUpdate(agentID, artefactID)
 }
 
 // Getting JSON artefact considering
 func (b *TModellingBusArtefactConnector) GetJSONArtefactConsidering(agentID, artefactID string) {
-	// Get the JSON artefact update
-	b.GetJSONArtefactUpdate(agentID, artefactID)
+	// In migrating mode, retrieve in the JSON version of the bus
+	if b.busConnector != nil {
+		b.getMigratedJSONArtefact(artefactConsideringPathElement, agentID, artefactID)
+		return
+	}
 
-	// Update the considered JSON artefact state
-	b.updateConsideringJSONArtefact(b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsConsideringTopicPath(artefactID)))
+	b.getJSONArtefactConsidering(agentID, artefactID)
+}
+
+/*
+ * Retrieving historic artefact versions
+ */
+
+// Listing the timestamps of the historic versions of a raw artefact
+func (b *TModellingBusArtefactConnector) ListRawArtefactVersions(agentID, artefactID string) []string {
+	return b.ModellingBusConnector.getPostingVersions(agentID, b.rawArtefactsTopicPath(artefactID))
+}
+
+// Listing the timestamps of the historic JSON artefact states
+func (b *TModellingBusArtefactConnector) ListJSONArtefactStateVersions(agentID, artefactID string) []string {
+	// In migrating mode, the history is in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().ListJSONArtefactStateVersions(agentID, artefactID)
+	}
+
+	return b.ModellingBusConnector.getPostingVersions(agentID, b.jsonArtefactsStateTopicPath(artefactID))
+}
+
+// Listing the timestamps of the historic JSON artefact updates
+func (b *TModellingBusArtefactConnector) ListJSONArtefactUpdateVersions(agentID, artefactID string) []string {
+	// In migrating mode, the history is in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().ListJSONArtefactUpdateVersions(agentID, artefactID)
+	}
+
+	return b.ModellingBusConnector.getPostingVersions(agentID, b.jsonArtefactsUpdateTopicPath(artefactID))
+}
+
+// Listing the timestamps of the historic JSON considered artefacts
+func (b *TModellingBusArtefactConnector) ListJSONArtefactConsideringVersions(agentID, artefactID string) []string {
+	// In migrating mode, the history is in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().ListJSONArtefactConsideringVersions(agentID, artefactID)
+	}
+
+	return b.ModellingBusConnector.getPostingVersions(agentID, b.jsonArtefactsConsideringTopicPath(artefactID))
+}
+
+// Getting a historic version of a raw artefact
+func (b *TModellingBusArtefactConnector) GetRawArtefactVersion(agentID, artefactID, timestamp, localFileName string) string {
+	return b.ModellingBusConnector.getFileVersionFromPosting(agentID, b.rawArtefactsTopicPath(artefactID), timestamp, localFileName)
+}
+
+// Getting a historic JSON artefact state
+func (b *TModellingBusArtefactConnector) GetJSONArtefactStateVersion(agentID, artefactID, timestamp string) (json.RawMessage, bool) {
+	// In migrating mode, migrate the historic state from the JSON version of the bus
+	if b.busConnector != nil {
+		busContent, ok := b.migratingConnector().GetJSONArtefactStateVersion(agentID, artefactID, timestamp)
+		if !ok {
+			return []byte{}, false
+		}
+
+		return b.migrateJSONArtefactContent(b.busConnector.JSONVersion, b.JSONVersion, busContent)
+	}
+
+	return b.ModellingBusConnector.getJSONVersion(agentID, b.jsonArtefactsStateTopicPath(artefactID), timestamp)
+}
+
+// Getting a historic JSON artefact update, i.e. the JSON delta as it was posted
+func (b *TModellingBusArtefactConnector) GetJSONArtefactUpdateVersion(agentID, artefactID, timestamp string) (json.RawMessage, bool) {
+	// In migrating mode, the deltas as they were posted are in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().GetJSONArtefactUpdateVersion(agentID, artefactID, timestamp)
+	}
+
+	return b.ModellingBusConnector.getJSONVersion(agentID, b.jsonArtefactsUpdateTopicPath(artefactID), timestamp)
+}
+
+// Getting a historic JSON considered artefact, i.e. the JSON delta as it was posted
+func (b *TModellingBusArtefactConnector) GetJSONArtefactConsideringVersion(agentID, artefactID, timestamp string) (json.RawMessage, bool) {
+	// In migrating mode, the deltas as they were posted are in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().GetJSONArtefactConsideringVersion(agentID, artefactID, timestamp)
+	}
+
+	return b.ModellingBusConnector.getJSONVersion(agentID, b.jsonArtefactsConsideringTopicPath(artefactID), timestamp)
+}
+
+/*
+ * Time-travelling JSON artefacts
+ */
+
+// Getting the JSON artefact content, including its updates, as it was at the given timestamp
+func (b *TModellingBusArtefactConnector) GetJSONArtefactAt(agentID, artefactID, timestamp string) (json.RawMessage, bool) {
+	// In migrating mode, reconstruct in the JSON version of the bus, and then migrate the result
+	if b.busConnector != nil {
+		busContent, ok := b.migratingConnector().GetJSONArtefactAt(agentID, artefactID, timestamp)
+		if !ok {
+			return []byte{}, false
+		}
+
+		return b.migrateJSONArtefactContent(b.busConnector.JSONVersion, b.JSONVersion, busContent)
+	}
+
+	updatedContent, _, _, ok := b.reconstructUpdatedJSONArtefact(agentID, artefactID, timestamp)
+
+	return updatedContent, ok
+}
+
+// Getting the considered JSON artefact content as it was at the given timestamp
+func (b *TModellingBusArtefactConnector) GetJSONArtefactConsideringAt(agentID, artefactID, timestamp string) (json.RawMessage, bool) {
+	// In migrating mode, reconstruct in the JSON version of the bus, and then migrate the result
+	if b.busConnector != nil {
+		busContent, ok := b.migratingConnector().GetJSONArtefactConsideringAt(agentID, artefactID, timestamp)
+		if !ok {
+			return []byte{}, false
+		}
+
+		return b.migrateJSONArtefactContent(b.busConnector.JSONVersion, b.JSONVersion, busContent)
+	}
+
+	// First reconstruct the updated content at the given timestamp
+	updatedContent, stateTimestamp, updatedTimestamp, ok := b.reconstructUpdatedJSONArtefact(agentID, artefactID, timestamp)
+	if !ok {
+		return []byte{}, false
+	}
+
+	// Then find the latest considering posted after the latest update, but not after the given timestamp
+	consideringVersions := b.ModellingBusConnector.listPostingVersions(agentID, b.jsonArtefactsConsideringTopicPath(artefactID))
+	consideringTimestamps := consideringVersions.timestamps()
+	for i := len(consideringTimestamps) - 1; i >= 0; i-- {
+		consideringTimestamp := consideringTimestamps[i]
+
+		// Only considerings between the latest update and the given timestamp are relevant
+		if generics.CompareTimestamps(consideringTimestamp, updatedTimestamp) <= 0 || generics.CompareTimestamps(consideringTimestamp, timestamp) > 0 {
+			continue
+		}
+
+		// Get the delta of the considering
+		deltaJSON, ok := b.ModellingBusConnector.getJSONVersionFrom(consideringVersions, consideringTimestamp)
+		if !ok {
+			continue
+		}
+		delta, ok := b.jsonDeltaFromJSON(deltaJSON)
+
+		// Only deltas against the used state, and for chained deltas against the latest update, are relevant
+		if !ok || delta.CurrentTimestamp != stateTimestamp || (delta.IsChained() && delta.PredecessorTimestamp != updatedTimestamp) {
+			continue
+		}
+
+		// Considerings are deltas against the updated content
+		consideredContent, err := delta.Apply(updatedContent)
+		if b.ModellingBusConnector.Reporter.MaybeReportError("Applying a historic diff patch did not work:", err) {
+			return []byte{}, false
+		}
+
+		return consideredContent, true
+	}
+
+	// Without a relevant considering, the considered content equals the updated content
+	return updatedContent, true
 }
 
 /*
@@ -367,10 +1166,21 @@ func (b *TModellingBusArtefactConnector) DeleteRawArtefact(artefactID string) {
 
 // Deleting JSON artefact
 func (b *TModellingBusArtefactConnector) DeleteJSONArtefact(artefactID string) {
+	// In migrating mode, the JSON artefact is posted in the JSON version of the bus
+	if b.busConnector != nil {
+		b.migratingConnector().DeleteJSONArtefact(artefactID)
+		return
+	}
+
 	// Delete the JSON artefact
 	b.ModellingBusConnector.deletePosting(b.jsonArtefactsStateTopicPath(artefactID))
 	b.ModellingBusConnector.deletePosting(b.jsonArtefactsUpdateTopicPath(artefactID))
 	b.ModellingBusConnector.deletePosting(b.jsonArtefactsConsideringTopicPath(artefactID))
+
+	// Delete the alternatives of the JSON artefact
+	for _, alternativeID := range b.GetJSONArtefactAlternatives(b.ModellingBusConnector.agentID, artefactID) {
+		b.ModellingBusConnector.modellingBusEventsConnector.deletePostingPath(b.jsonArtefactsAlternativeTopicPath(artefactID, alternativeID))
+	}
 }
 
 /*
@@ -387,10 +1197,14 @@ func CreateModellingBusArtefactConnector(ModellingBusConnector TModellingBusConn
 	ModellingBusArtefactConnector.CurrentContent = []byte{}
 	ModellingBusArtefactConnector.UpdatedContent = []byte{}
 	ModellingBusArtefactConnector.ConsideredContent = []byte{}
+	ModellingBusArtefactConnector.AlternativeContents = map[string]json.RawMessage{}
+	ModellingBusArtefactConnector.AlternativeTimestamps = map[string]string{}
 	ModellingBusArtefactConnector.CurrentTimestamp = generics.GetTimestamp()
 	ModellingBusArtefactConnector.UpdatedTimestamp = ModellingBusArtefactConnector.CurrentTimestamp
 	ModellingBusArtefactConnector.ConsideredTimestamp = ModellingBusArtefactConnector.CurrentTimestamp
 	ModellingBusArtefactConnector.stateCommunicated = false
+	ModellingBusArtefactConnector.pendingProposals = map[string]TJSONArtefactProposal{}
+	ModellingBusArtefactConnector.pendingProposalsMutex = &sync.Mutex{}
 
 	// Return the created modelling bus artefact connector
 	return ModellingBusArtefactConnector
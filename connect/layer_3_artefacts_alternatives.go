@@ -0,0 +1,227 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Alternatives
+ *
+ * This component provides the functionality to post, and listen to, named alternatives for the considered
+ * content of artefacts on the BIG Modelling Bus.
+ * Next to the (single) considering of an artefact, a poster can weigh several alternatives at once. Each
+ * alternative is identified by an alternative ID, and is posted as a delta against the updated content.
+ * An alternative can be promoted to become the update of the artefact.
+ *
+ * As alternatives are deltas against the updated content, they refer to the update they are against.
+ * Once a new update (or state) is posted, the earlier alternatives are no longer applicable. The poster then
+ * posts a discard for each of them, so listeners do not keep alternatives against an earlier update.
+ *
+ * Alternative IDs are used as the last element of a topic path, and can therefore not be empty, nor contain
+ * the MQTT topic separator ("/") or wildcards ("+" and "#").
+ *
+ * Topics involved:
+ * - <agent>/artefacts/json/<artefact id>/<json version>/considering/<alternative id>
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining topic paths
+ */
+
+// Defining topic paths for json artefact alternatives
+func (b *TModellingBusArtefactConnector) jsonArtefactsAlternativeTopicPath(artefactID, alternativeID string) string {
+	return b.jsonArtefactsConsideringTopicPath(artefactID) +
+		"/" + alternativeID
+}
+
+/*
+ * Managing alternatives
+ */
+
+// Clearing the alternatives, as they are no longer applicable after a new update or state
+func (b *TModellingBusArtefactConnector) clearJSONArtefactAlternatives() {
+	b.AlternativeContents = map[string]json.RawMessage{}
+	b.AlternativeTimestamps = map[string]string{}
+}
+
+// Discarding the alternatives posted by us, as they are superseded by a new update or state
+func (b *TModellingBusArtefactConnector) discardJSONArtefactAlternatives() {
+	for alternativeID := range b.AlternativeContents {
+		b.postJSONArtefactAction(b.jsonArtefactsAlternativeTopicPath(b.ArtefactID, alternativeID), JSONArtefactActionDiscard, b.UpdatedContent, b.UpdatedTimestamp)
+	}
+
+	b.clearJSONArtefactAlternatives()
+}
+
+// Checking whether an alternative ID can be used as the last element of a topic path
+func (b *TModellingBusArtefactConnector) validJSONArtefactAlternativeID(alternativeID string) bool {
+	if alternativeID == "" || strings.ContainsAny(alternativeID, "/+#") {
+		b.ModellingBusConnector.Reporter.Error("Invalid alternative ID %q for artefact %s, as it is empty, or contains a '/', '+' or '#'.", alternativeID, b.ArtefactID)
+		return false
+	}
+
+	return true
+}
+
+// Updating an alternative from a received delta, returning whether the delta could be applied
+func (b *TModellingBusArtefactConnector) updateAlternativeJSONArtefact(alternativeID string, json []byte) bool {
+	b.validationErrors = []generics.TJSONValidationError{}
+
+	// Unmarshal the delta
+	delta, ok := b.jsonDeltaFromJSON(json)
+	if !ok {
+		return false
+	}
+
+	// A discarded alternative is no longer available
+	if delta.Action == JSONArtefactActionDiscard {
+		delete(b.AlternativeContents, alternativeID)
+		delete(b.AlternativeTimestamps, alternativeID)
+
+		return true
+	}
+
+	// The alternative must be against the update we know of
+	if delta.PredecessorTimestamp != b.UpdatedTimestamp {
+		b.syncProblem = "alternative " + alternativeID + " is against update " + delta.PredecessorTimestamp + ", while the known update is " + b.UpdatedTimestamp
+		return false
+	}
+
+	// Apply the delta to the updated content
+	alternativeContent, ok := b.applyJSONDelta(b.UpdatedContent, delta)
+	if ok {
+		b.AlternativeContents[alternativeID] = alternativeContent
+		b.AlternativeTimestamps[alternativeID] = delta.Timestamp
+	}
+
+	return ok
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+/*
+ * Posting alternatives
+ */
+
+// Posting a named alternative for the considered content of the JSON artefact
+func (b *TModellingBusArtefactConnector) PostJSONArtefactAlternative(alternativeID string, alternativeStateJSON []byte, okJSONing bool) {
+	// If not ok, or not valid, then do not proceed
+	if !okJSONing || b.rejectedInMigratingMode("post alternative "+alternativeID) || !b.validJSONArtefactAlternativeID(alternativeID) || b.maybeReportInvalidJSONArtefactContent(alternativeStateJSON) {
+		return
+	}
+
+	// Ensure the state has been communicated
+	if !b.stateCommunicated {
+		b.postJSONArtefactState(b.CurrentContent)
+	}
+
+	// Post the alternative as a delta against the updated content
+	if timestamp, ok := b.postJSONDelta(b.jsonArtefactsAlternativeTopicPath(b.ArtefactID, alternativeID), b.UpdatedContent, alternativeStateJSON, b.UpdatedTimestamp); ok {
+		b.AlternativeContents[alternativeID] = alternativeStateJSON
+		b.AlternativeTimestamps[alternativeID] = timestamp
+	}
+}
+
+// Promoting a named alternative to become the update of the JSON artefact
+func (b *TModellingBusArtefactConnector) PromoteJSONArtefactAlternative(alternativeID string) bool {
+	if b.rejectedInMigratingMode("promote alternative " + alternativeID) {
+		return false
+	}
+
+	// Get the content of the alternative
+	alternativeContent, known := b.AlternativeContents[alternativeID]
+	if !known {
+		b.ModellingBusConnector.Reporter.Error("Unknown alternative %s for artefact %s.", alternativeID, b.ArtefactID)
+		return false
+	}
+
+	// Remove the alternative, as it has been promoted
+	b.DeleteJSONArtefactAlternative(alternativeID)
+
+	// Post the alternative as the update
+	b.PostJSONArtefactUpdate(alternativeContent, true)
+
+	return true
+}
+
+/*
+ * Listening to alternatives
+ */
+
+// Listening for JSON artefact alternative postings, where the handler receives the ID of the posted alternative
+func (b *TModellingBusArtefactConnector) ListenForJSONArtefactAlternativePostings(agentID, artefactID string, handler func(string)) {
+	if b.rejectedInMigratingMode("listen for alternatives") {
+		return
+	}
+
+	// Listen for postings on the sub-topics of the considering topic
+	b.ModellingBusConnector.listenForJSONFilePostingsOnSubTopics(agentID, b.jsonArtefactsConsideringTopicPath(artefactID), func(alternativeID string, json []byte, timestamp string) {
+		if b.updateAlternativeJSONArtefact(alternativeID, json) {
+			b.markInSync()
+			handler(alternativeID)
+		} else if b.hasInvalidJSONArtefactContent() {
+			b.rejectJSONArtefactPosting(agentID, artefactID, artefactConsideringPathElement+"/"+alternativeID, timestamp)
+		} else if b.resynchroniseJSONArtefact(agentID, artefactID, artefactConsideringPathElement+"/"+alternativeID) && b.updateAlternativeJSONArtefact(alternativeID, json) {
+			handler(alternativeID)
+		}
+	})
+}
+
+/*
+ * Retrieving alternatives
+ */
+
+// Getting the IDs of the alternatives currently posted for the JSON artefact
+// Note: this requires the modelling bus connector not to be created in posting only mode.
+func (b *TModellingBusArtefactConnector) GetJSONArtefactAlternatives(agentID, artefactID string) []string {
+	if b.rejectedInMigratingMode("get the alternatives") {
+		return []string{}
+	}
+
+	return b.ModellingBusConnector.modellingBusEventsConnector.subTopicsWithEventsOn(agentID, b.jsonArtefactsConsideringTopicPath(artefactID))
+}
+
+// Getting a named alternative of the JSON artefact, relative to the known updated content
+func (b *TModellingBusArtefactConnector) GetJSONArtefactAlternative(agentID, artefactID, alternativeID string) (json.RawMessage, bool) {
+	if b.rejectedInMigratingMode("get alternative " + alternativeID) {
+		return nil, false
+	}
+
+	// Get the alternative
+	alternativeJSON, _ := b.ModellingBusConnector.getJSON(agentID, b.jsonArtefactsAlternativeTopicPath(artefactID, alternativeID))
+	if len(alternativeJSON) == 0 || !b.updateAlternativeJSONArtefact(alternativeID, alternativeJSON) {
+		return nil, false
+	}
+
+	return b.AlternativeContents[alternativeID], true
+}
+
+/*
+ * Deleting alternatives
+ */
+
+// Deleting a named alternative of the JSON artefact
+func (b *TModellingBusArtefactConnector) DeleteJSONArtefactAlternative(alternativeID string) {
+	if b.rejectedInMigratingMode("delete alternative " + alternativeID) {
+		return
+	}
+
+	delete(b.AlternativeContents, alternativeID)
+	delete(b.AlternativeTimestamps, alternativeID)
+	b.ModellingBusConnector.deletePosting(b.jsonArtefactsAlternativeTopicPath(b.ArtefactID, alternativeID))
+}
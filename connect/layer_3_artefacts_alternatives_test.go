@@ -0,0 +1,97 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Alternatives (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+func TestValidJSONArtefactAlternativeID(t *testing.T) {
+	tests := []struct {
+		alternativeID string
+		valid         bool
+	}{
+		{"option-1", true},
+		{"Option B", true},
+		{"", false},
+		{"a/b", false},
+		{"a+", false},
+		{"#", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.alternativeID, func(t *testing.T) {
+			b := createTestArtefactConnector(t)
+			if valid := b.validJSONArtefactAlternativeID(test.alternativeID); valid != test.valid {
+				t.Errorf("got %t, want %t", valid, test.valid)
+			}
+		})
+	}
+}
+
+func TestUpdateAlternativeJSONArtefact(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	stateTimestamp := b.CurrentTimestamp
+
+	// An alternative against the known update is applied to the updated content
+	alternative := testDeltaJSON(t, `{"a":0}`, `{"a":0,"b":1}`, stateTimestamp, b.UpdatedTimestamp)
+	if !b.updateAlternativeJSONArtefact("option", alternative) {
+		t.Fatalf("the alternative against the known update should be applied")
+	}
+	assertEqualJSON(t, b.AlternativeContents["option"], []byte(`{"a":0,"b":1}`))
+
+	// A discarded alternative is no longer available
+	discard := testActionJSON(t, JSONArtefactActionDiscard, `{"a":0}`, stateTimestamp, b.UpdatedTimestamp)
+	if !b.updateAlternativeJSONArtefact("option", discard) {
+		t.Fatalf("the discard of the alternative should be applied")
+	}
+	if _, known := b.AlternativeContents["option"]; known {
+		t.Errorf("the discarded alternative should no longer be available")
+	}
+}
+
+func TestAlternativesSupersededByUpdate(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	stateTimestamp := b.CurrentTimestamp
+	alternative := testDeltaJSON(t, `{"a":0}`, `{"a":0,"b":1}`, stateTimestamp, b.UpdatedTimestamp)
+	b.updateAlternativeJSONArtefact("option", alternative)
+
+	// A new update means the alternatives against the previous update are no longer applicable
+	if !b.updateUpdatedJSONArtefact(testDeltaJSON(t, `{"a":0}`, `{"a":1}`, stateTimestamp, ""), "") {
+		t.Fatalf("the update should be applied")
+	}
+	if len(b.AlternativeContents) != 0 {
+		t.Errorf("got alternatives %v after a new update, want none", b.AlternativeContents)
+	}
+
+	// So, receiving an alternative against the previous update requires re-synchronisation
+	if b.updateAlternativeJSONArtefact("option", alternative) {
+		t.Errorf("an alternative against a previous update should not be applied")
+	}
+	if b.syncProblem == "" || b.hasInvalidJSONArtefactContent() {
+		t.Errorf("an alternative against a previous update should require re-synchronisation")
+	}
+}
+
+func TestAlternativesSupersededByState(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	alternative := testDeltaJSON(t, `{"a":0}`, `{"a":0,"b":1}`, b.CurrentTimestamp, b.UpdatedTimestamp)
+	b.updateAlternativeJSONArtefact("option", alternative)
+
+	b.updateCurrentJSONArtefact([]byte(`{"a":1}`), generics.GetTimestamp())
+	if len(b.AlternativeContents) != 0 {
+		t.Errorf("got alternatives %v after a new state, want none", b.AlternativeContents)
+	}
+}
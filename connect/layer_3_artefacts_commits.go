@@ -0,0 +1,227 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Commits
+ *
+ * This component provides the functionality to commit, or discard, the update and considering of artefacts
+ * on the BIG Modelling Bus.
+ * Committing an update makes it the new state, while committing a considering makes it the new update.
+ * Discarding an update (or considering) returns to the state (or update).
+ *
+ * Commits and discards are posted as deltas marked with the corresponding action. This allows listeners to
+ * distinguish an intentional discard from a posting that has been deleted, or is missing, in which case the
+ * action as received by the listener is JSONArtefactActionMissing.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+/*
+ * Defining constants
+ */
+
+const (
+	JSONArtefactActionChange  = ""        // A regular change of the layer
+	JSONArtefactActionCommit  = "commit"  // The layer has been committed to the layer below
+	JSONArtefactActionDiscard = "discard" // The layer has been discarded, returning to the layer below
+	JSONArtefactActionMissing = "missing" // The posting of the layer has been deleted, or is missing (only used by listeners)
+)
+
+/*
+ * Posting actions
+ */
+
+// Posting an action on a layer, as an empty delta resulting in the given content, returning the timestamp of the posted delta
+func (b *TModellingBusArtefactConnector) postJSONArtefactAction(topicPath, action string, content json.RawMessage, predecessorTimestamp string) (string, bool) {
+	// Create the delta
+	delta, ok := b.createJSONDelta(content, content)
+	if !ok {
+		return "", false
+	}
+	delta.PredecessorTimestamp = predecessorTimestamp
+	delta.Action = action
+
+	// Post the delta
+	b.postJSONDeltaObject(topicPath, delta)
+
+	return delta.Timestamp, true
+}
+
+// Determining the predecessor of an action, which is only needed in chained mode
+func (b *TModellingBusArtefactConnector) actionPredecessorTimestamp() string {
+	if b.chainedUpdates {
+		return b.UpdatedTimestamp
+	}
+
+	return ""
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+/*
+ * Committing
+ */
+
+// Committing the update of the JSON artefact, making it the new state.
+// A considering that differs from the update is re-posted against the new state.
+func (b *TModellingBusArtefactConnector) CommitUpdate() bool {
+	// In migrating mode, commit in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().CommitUpdate() && b.presentMigratedJSONArtefact()
+	}
+
+	// Without a communicated state, there is nothing to commit
+	if !b.stateCommunicated {
+		b.ModellingBusConnector.Reporter.Error("Cannot commit the update of artefact %s, as its state has not been posted.", b.ArtefactID)
+		return false
+	}
+
+	// Keep the considered content, in case it differs from the update
+	consideredContent := b.ConsideredContent
+	hasConsidering := !bytes.Equal(b.ConsideredContent, b.UpdatedContent)
+
+	// Post the update as the new state
+	b.PostJSONArtefactState(b.UpdatedContent, true)
+
+	// Post the commit of the update
+	timestamp, ok := b.postJSONArtefactAction(b.jsonArtefactsUpdateTopicPath(b.ArtefactID), JSONArtefactActionCommit, b.CurrentContent, b.actionPredecessorTimestamp())
+	if !ok {
+		return false
+	}
+	b.UpdatedTimestamp = timestamp
+	b.ConsideredTimestamp = timestamp
+
+	// Re-post the considering against the new state
+	if hasConsidering {
+		b.postJSONArtefactConsidering(consideredContent)
+	}
+
+	return true
+}
+
+// Committing the considering of the JSON artefact, making it the new update
+func (b *TModellingBusArtefactConnector) CommitConsidering() bool {
+	// In migrating mode, commit in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().CommitConsidering() && b.presentMigratedJSONArtefact()
+	}
+
+	// Without a communicated state, there is nothing to commit
+	if !b.stateCommunicated {
+		b.ModellingBusConnector.Reporter.Error("Cannot commit the considering of artefact %s, as its state has not been posted.", b.ArtefactID)
+		return false
+	}
+
+	// Post the considered content as the update
+	b.PostJSONArtefactUpdate(b.ConsideredContent, true)
+
+	// Post the commit of the considering
+	timestamp, ok := b.postJSONArtefactAction(b.jsonArtefactsConsideringTopicPath(b.ArtefactID), JSONArtefactActionCommit, b.UpdatedContent, b.actionPredecessorTimestamp())
+	if ok {
+		b.ConsideredTimestamp = timestamp
+	}
+
+	return ok
+}
+
+/*
+ * Discarding
+ */
+
+// Discarding the update (and considering) of the JSON artefact, returning to its state
+func (b *TModellingBusArtefactConnector) DiscardUpdate() bool {
+	// In migrating mode, discard in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().DiscardUpdate() && b.presentMigratedJSONArtefact()
+	}
+
+	// Without a communicated state, there is nothing to discard
+	if !b.stateCommunicated {
+		b.ModellingBusConnector.Reporter.Error("Cannot discard the update of artefact %s, as its state has not been posted.", b.ArtefactID)
+		return false
+	}
+
+	// Record the change, so it can be undone
+	b.recordJSONArtefactChange(artefactUpdatePathElement, b.UpdatedContent, b.CurrentContent)
+
+	// Post the discard of the update
+	timestamp, ok := b.postJSONArtefactAction(b.jsonArtefactsUpdateTopicPath(b.ArtefactID), JSONArtefactActionDiscard, b.CurrentContent, b.actionPredecessorTimestamp())
+	if !ok {
+		return false
+	}
+	b.UpdatedContent = b.CurrentContent
+	b.ConsideredContent = b.CurrentContent
+	b.UpdatedTimestamp = timestamp
+	b.ConsideredTimestamp = timestamp
+	b.discardJSONArtefactAlternatives()
+
+	// Post the discard of the considering, which was against the discarded update
+	if timestamp, ok := b.postJSONArtefactAction(b.jsonArtefactsConsideringTopicPath(b.ArtefactID), JSONArtefactActionDiscard, b.UpdatedContent, b.actionPredecessorTimestamp()); ok {
+		b.ConsideredTimestamp = timestamp
+	}
+
+	return true
+}
+
+// Discarding the considering of the JSON artefact, returning to its update
+func (b *TModellingBusArtefactConnector) DiscardConsidering() bool {
+	// In migrating mode, discard in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().DiscardConsidering() && b.presentMigratedJSONArtefact()
+	}
+
+	// Without a communicated state, there is nothing to discard
+	if !b.stateCommunicated {
+		b.ModellingBusConnector.Reporter.Error("Cannot discard the considering of artefact %s, as its state has not been posted.", b.ArtefactID)
+		return false
+	}
+
+	// Record the change, so it can be undone
+	b.recordJSONArtefactChange(artefactConsideringPathElement, b.ConsideredContent, b.UpdatedContent)
+
+	// Post the discard of the considering
+	timestamp, ok := b.postJSONArtefactAction(b.jsonArtefactsConsideringTopicPath(b.ArtefactID), JSONArtefactActionDiscard, b.UpdatedContent, b.actionPredecessorTimestamp())
+	if ok {
+		b.ConsideredContent = b.UpdatedContent
+		b.ConsideredTimestamp = timestamp
+	}
+
+	return ok
+}
+
+// Discarding a named alternative of the JSON artefact.
+// Unlike deleting the alternative, listeners are informed of the discard.
+func (b *TModellingBusArtefactConnector) DiscardJSONArtefactAlternative(alternativeID string) bool {
+	if b.rejectedInMigratingMode("discard alternative " + alternativeID) {
+		return false
+	}
+
+	// The alternative must be known
+	if _, known := b.AlternativeContents[alternativeID]; !known {
+		b.ModellingBusConnector.Reporter.Error("Unknown alternative %s for artefact %s.", alternativeID, b.ArtefactID)
+		return false
+	}
+
+	// Post the discard of the alternative
+	if _, ok := b.postJSONArtefactAction(b.jsonArtefactsAlternativeTopicPath(b.ArtefactID, alternativeID), JSONArtefactActionDiscard, b.UpdatedContent, b.UpdatedTimestamp); !ok {
+		return false
+	}
+	delete(b.AlternativeContents, alternativeID)
+	delete(b.AlternativeTimestamps, alternativeID)
+
+	return true
+}
@@ -0,0 +1,138 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Commits (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+// Receiving a posting on the given layer
+func receiveTestPosting(b *TModellingBusArtefactConnector, layer string, json []byte) bool {
+	if layer == artefactUpdatePathElement {
+		return b.updateUpdatedJSONArtefact(json, "")
+	}
+
+	return b.updateConsideringJSONArtefact(json, "")
+}
+
+// Checking the actions of the update and considering layers, as received by a listener
+func assertLayerActions(t *testing.T, b *TModellingBusArtefactConnector, updateAction, consideringAction string) {
+	t.Helper()
+
+	if b.UpdateAction != updateAction {
+		t.Errorf("got update action %q, want %q", b.UpdateAction, updateAction)
+	}
+	if b.ConsideringAction != consideringAction {
+		t.Errorf("got considering action %q, want %q", b.ConsideringAction, consideringAction)
+	}
+}
+
+func TestReceivingActions(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	stateTimestamp := b.CurrentTimestamp
+
+	tests := []struct {
+		name              string
+		layer             string
+		json              []byte
+		updated           string
+		considered        string
+		updateAction      string
+		consideringAction string
+	}{
+		{"change the update", artefactUpdatePathElement, testDeltaJSON(t, `{"a":0}`, `{"a":1}`, stateTimestamp, ""),
+			`{"a":1}`, `{"a":1}`, JSONArtefactActionChange, JSONArtefactActionChange},
+		{"change the considering", artefactConsideringPathElement, testDeltaJSON(t, `{"a":1}`, `{"a":1,"b":1}`, stateTimestamp, ""),
+			`{"a":1}`, `{"a":1,"b":1}`, JSONArtefactActionChange, JSONArtefactActionChange},
+		{"discard the considering", artefactConsideringPathElement, testActionJSON(t, JSONArtefactActionDiscard, `{"a":1}`, stateTimestamp, ""),
+			`{"a":1}`, `{"a":1}`, JSONArtefactActionChange, JSONArtefactActionDiscard},
+		{"missing considering", artefactConsideringPathElement, []byte{},
+			`{"a":1}`, `{"a":1}`, JSONArtefactActionChange, JSONArtefactActionMissing},
+		{"discard the update", artefactUpdatePathElement, testActionJSON(t, JSONArtefactActionDiscard, `{"a":0}`, stateTimestamp, ""),
+			`{"a":0}`, `{"a":0}`, JSONArtefactActionDiscard, JSONArtefactActionDiscard},
+		{"missing update", artefactUpdatePathElement, []byte{},
+			`{"a":0}`, `{"a":0}`, JSONArtefactActionMissing, JSONArtefactActionMissing},
+	}
+
+	// The postings build on each other, so they are received in the given order
+	for _, test := range tests {
+		if !receiveTestPosting(b, test.layer, test.json) {
+			t.Fatalf("%s: the posting should be applied (%s)", test.name, b.syncProblem)
+		}
+		assertLayerContents(t, b, test.updated, test.considered)
+		assertLayerActions(t, b, test.updateAction, test.consideringAction)
+	}
+}
+
+func TestReceivingCommittedUpdate(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	b.updateUpdatedJSONArtefact(testDeltaJSON(t, `{"a":0}`, `{"a":1}`, b.CurrentTimestamp, ""), "")
+
+	// Committing the update posts it as the new state, followed by the commit against that state
+	stateTimestamp := generics.GetTimestamp()
+	b.updateCurrentJSONArtefact([]byte(`{"a":1}`), stateTimestamp)
+	if !b.updateUpdatedJSONArtefact(testActionJSON(t, JSONArtefactActionCommit, `{"a":1}`, stateTimestamp, ""), "") {
+		t.Fatalf("the commit should be applied (%s)", b.syncProblem)
+	}
+	assertEqualJSON(t, b.CurrentContent, []byte(`{"a":1}`))
+	assertLayerContents(t, b, `{"a":1}`, `{"a":1}`)
+	assertLayerActions(t, b, JSONArtefactActionCommit, JSONArtefactActionCommit)
+}
+
+func TestReceivingChainedActions(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	stateTimestamp := b.CurrentTimestamp
+	b.updateUpdatedJSONArtefact(testDeltaJSON(t, `{"a":0}`, `{"a":1}`, stateTimestamp, stateTimestamp), "")
+
+	// A chained discard results in the state, even when its predecessor is not the known update
+	if !b.updateUpdatedJSONArtefact(testActionJSON(t, JSONArtefactActionDiscard, `{"a":0}`, stateTimestamp, "unknown"), "") {
+		t.Fatalf("the discard should be applied (%s)", b.syncProblem)
+	}
+	assertLayerContents(t, b, `{"a":0}`, `{"a":0}`)
+	assertLayerActions(t, b, JSONArtefactActionDiscard, JSONArtefactActionDiscard)
+}
+
+func TestActionsRequireCommunicatedState(t *testing.T) {
+	tests := []struct {
+		name   string
+		action func(*TModellingBusArtefactConnector) bool
+	}{
+		{"commit update", (*TModellingBusArtefactConnector).CommitUpdate},
+		{"commit considering", (*TModellingBusArtefactConnector).CommitConsidering},
+		{"discard update", (*TModellingBusArtefactConnector).DiscardUpdate},
+		{"discard considering", (*TModellingBusArtefactConnector).DiscardConsidering},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := createTestArtefactConnector(t)
+			if test.action(b) {
+				t.Errorf("without a communicated state, there is nothing to %s", test.name)
+			}
+		})
+	}
+}
+
+func TestActionPredecessorTimestamp(t *testing.T) {
+	b := createTestListener(t, `{"a":0}`)
+	if predecessor := b.actionPredecessorTimestamp(); predecessor != "" {
+		t.Errorf("got predecessor %q for cumulative updates, want none", predecessor)
+	}
+
+	b.UseChainedUpdates(0, 0)
+	if predecessor := b.actionPredecessorTimestamp(); predecessor != b.UpdatedTimestamp {
+		t.Errorf("got predecessor %q for chained updates, want %q", predecessor, b.UpdatedTimestamp)
+	}
+}
@@ -0,0 +1,296 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Migrations
+ *
+ * This component provides the migration of JSON artefacts between JSON versions on the BIG Modelling Bus.
+ * JSON versions (such as "cdm-v1.0-v1.0") encode the meta-model and serialisation versions of artefacts.
+ * Migration functions between JSON versions are registered, and can be chained to migrate across several versions.
+ *
+ * An artefact connector can be put in migrating mode, in which it communicates on the bus in one JSON version,
+ * while presenting (and accepting) content in its own JSON version. This allows agents to upgrade independently:
+ * - a listener for a newer version can follow an artefact that is still posted in an older version;
+ * - a poster using a newer version can keep posting the artefact in the older version.
+ * In migrating mode, the posting, listening to, and retrieval of the state, update and considering of the
+ * artefact are migrated. The deltas on the bus are computed and applied in the JSON version of the bus.
+ * The same holds for committing, discarding, undoing and redoing, the history of the artefact (where the
+ * historic deltas are given as they were posted on the bus), its rejections, and its deletion. Alternatives and proposals are not supported in migrating mode, and are rejected.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+/*
+ * Defining migrations
+ */
+
+type (
+	// A migration of a JSON from one JSON version to another
+	TJSONMigration func([]byte) ([]byte, error)
+)
+
+/*
+ * Registering migrations
+ */
+
+var (
+	jsonMigrations      = map[string]map[string]TJSONMigration{} // The migrations, by the JSON versions they migrate from and to
+	jsonMigrationsMutex sync.RWMutex                             // Guarding the access to the migrations
+)
+
+// Finding the chain of migrations from one JSON version to another, using a breadth first search
+func jsonMigrationChain(fromJSONVersion, toJSONVersion string) ([]TJSONMigration, bool) {
+	jsonMigrationsMutex.RLock()
+	defer jsonMigrationsMutex.RUnlock()
+
+	// The chains of migrations leading to the JSON versions reached so far
+	chains := map[string][]TJSONMigration{fromJSONVersion: {}}
+	frontier := []string{fromJSONVersion}
+
+	for len(frontier) > 0 {
+		jsonVersion := frontier[0]
+		frontier = frontier[1:]
+
+		// Found it
+		if jsonVersion == toJSONVersion {
+			return chains[jsonVersion], true
+		}
+
+		// Extend the chain with the migrations from this JSON version
+		for nextJSONVersion, migration := range jsonMigrations[jsonVersion] {
+			if _, reached := chains[nextJSONVersion]; !reached {
+				chains[nextJSONVersion] = append(append([]TJSONMigration{}, chains[jsonVersion]...), migration)
+				frontier = append(frontier, nextJSONVersion)
+			}
+		}
+	}
+
+	return nil, false
+}
+
+/*
+ * Migrating connectors
+ */
+
+// Getting the connector used to communicate in the JSON version of the bus, sharing our configuration
+func (b *TModellingBusArtefactConnector) migratingConnector() *TModellingBusArtefactConnector {
+	b.busConnector.chainedUpdates = b.chainedUpdates
+	b.busConnector.maxChainedUpdates = b.maxChainedUpdates
+	b.busConnector.maxChainedDeltaSize = b.maxChainedDeltaSize
+	b.busConnector.deltaFormat = b.deltaFormat
+	b.busConnector.outOfSyncHandler = b.outOfSyncHandler
+
+	return b.busConnector
+}
+
+// Migrating content between the JSON version of the bus and the presented JSON version
+func (b *TModellingBusArtefactConnector) migrateJSONArtefactContent(fromJSONVersion, toJSONVersion string, content json.RawMessage) (json.RawMessage, bool) {
+	// Empty content remains empty
+	if len(content) == 0 {
+		return content, true
+	}
+
+	// Migrate the content
+	migratedContent, err := MigrateJSON(fromJSONVersion, toJSONVersion, content)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong migrating artefact "+b.ArtefactID+":", err) {
+		return nil, false
+	}
+
+	return migratedContent, true
+}
+
+// Presenting the content of the connector for the bus in our JSON version
+func (b *TModellingBusArtefactConnector) presentMigratedJSONArtefact() bool {
+	busConnector := b.busConnector
+
+	// Migrate the content of the layers
+	currentContent, ok := b.migrateJSONArtefactContent(busConnector.JSONVersion, b.JSONVersion, busConnector.CurrentContent)
+	if !ok {
+		return false
+	}
+	updatedContent, ok := b.migrateJSONArtefactContent(busConnector.JSONVersion, b.JSONVersion, busConnector.UpdatedContent)
+	if !ok {
+		return false
+	}
+	consideredContent, ok := b.migrateJSONArtefactContent(busConnector.JSONVersion, b.JSONVersion, busConnector.ConsideredContent)
+	if !ok {
+		return false
+	}
+
+	// Adopt the migrated content
+	b.CurrentContent = currentContent
+	b.UpdatedContent = updatedContent
+	b.ConsideredContent = consideredContent
+
+	b.CurrentTimestamp = busConnector.CurrentTimestamp
+	b.UpdatedTimestamp = busConnector.UpdatedTimestamp
+	b.ConsideredTimestamp = busConnector.ConsideredTimestamp
+
+	b.UpdateAction = busConnector.UpdateAction
+	b.ConsideringAction = busConnector.ConsideringAction
+
+	b.stateCommunicated = busConnector.stateCommunicated
+	b.outOfSync = busConnector.outOfSync
+
+	return true
+}
+
+// Rejecting an operation that is not supported in migrating mode, returning whether it was rejected
+func (b *TModellingBusArtefactConnector) rejectedInMigratingMode(operation string) bool {
+	if b.busConnector == nil {
+		return false
+	}
+
+	b.ModellingBusConnector.Reporter.Error("Cannot %s of artefact %s, as this is not supported in migrating mode.", operation, b.ArtefactID)
+
+	return true
+}
+
+// Posting content of the given layer in the JSON version of the bus
+func (b *TModellingBusArtefactConnector) postMigratedJSONArtefact(layer string, content []byte) {
+	// Migrate the content to the JSON version of the bus
+	busContent, ok := b.migrateJSONArtefactContent(b.JSONVersion, b.busConnector.JSONVersion, content)
+	if !ok {
+		return
+	}
+
+	// Post it
+	busConnector := b.migratingConnector()
+	switch layer {
+	case artefactStatePathElement:
+		busConnector.PostJSONArtefactState(busContent, true)
+	case artefactUpdatePathElement:
+		busConnector.PostJSONArtefactUpdate(busContent, true)
+	case artefactConsideringPathElement:
+		busConnector.PostJSONArtefactConsidering(busContent, true)
+	}
+
+	b.presentMigratedJSONArtefact()
+}
+
+// Listening for postings of the given layer in the JSON version of the bus
+func (b *TModellingBusArtefactConnector) listenForMigratedJSONArtefactPostings(layer, agentID, artefactID string, handler func()) {
+	// Present the received content in our JSON version
+	migratedHandler := func() {
+		if b.presentMigratedJSONArtefact() {
+			handler()
+		}
+	}
+
+	// Listen for the postings
+	busConnector := b.migratingConnector()
+	switch layer {
+	case artefactStatePathElement:
+		busConnector.ListenForJSONArtefactStatePostings(agentID, artefactID, migratedHandler)
+	case artefactUpdatePathElement:
+		busConnector.ListenForJSONArtefactUpdatePostings(agentID, artefactID, migratedHandler)
+	case artefactConsideringPathElement:
+		busConnector.ListenForJSONArtefactConsideringPostings(agentID, artefactID, migratedHandler)
+	}
+}
+
+// Retrieving the given layer in the JSON version of the bus
+func (b *TModellingBusArtefactConnector) getMigratedJSONArtefact(layer, agentID, artefactID string) bool {
+	// Retrieve the layer
+	busConnector := b.migratingConnector()
+	ok := false
+	switch layer {
+	case artefactStatePathElement:
+		ok = busConnector.getJSONArtefactState(agentID, artefactID)
+	case artefactUpdatePathElement:
+		ok = busConnector.getJSONArtefactUpdate(agentID, artefactID)
+	case artefactConsideringPathElement:
+		ok = busConnector.getJSONArtefactConsidering(agentID, artefactID)
+	}
+
+	return ok && b.presentMigratedJSONArtefact()
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Registering a migration of JSONs from one JSON version to another
+func RegisterJSONMigration(fromJSONVersion, toJSONVersion string, migration TJSONMigration) {
+	jsonMigrationsMutex.Lock()
+	defer jsonMigrationsMutex.Unlock()
+
+	if _, known := jsonMigrations[fromJSONVersion]; !known {
+		jsonMigrations[fromJSONVersion] = map[string]TJSONMigration{}
+	}
+	jsonMigrations[fromJSONVersion][toJSONVersion] = migration
+}
+
+// Checking whether JSONs can be migrated from one JSON version to another, possibly via other JSON versions
+func CanMigrateJSON(fromJSONVersion, toJSONVersion string) bool {
+	_, found := jsonMigrationChain(fromJSONVersion, toJSONVersion)
+
+	return found
+}
+
+// Migrating a JSON from one JSON version to another, possibly via other JSON versions
+func MigrateJSON(fromJSONVersion, toJSONVersion string, message []byte) ([]byte, error) {
+	// Find the migrations to apply
+	migrations, found := jsonMigrationChain(fromJSONVersion, toJSONVersion)
+	if !found {
+		return nil, fmt.Errorf("no migration from %s to %s", fromJSONVersion, toJSONVersion)
+	}
+
+	// Apply them
+	migratedMessage := message
+	for _, migration := range migrations {
+		var err error
+		migratedMessage, err = migration(migratedMessage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return migratedMessage, nil
+}
+
+// Communicating on the bus in the given JSON version, while presenting content in the JSON version of the connector.
+// Migrations from the JSON version of the bus to the one of the connector are needed for listening, and in
+// the other direction for posting.
+func (b *TModellingBusArtefactConnector) UseJSONVersionMigration(busJSONVersion string) bool {
+	// At least one direction should be supported
+	if !CanMigrateJSON(busJSONVersion, b.JSONVersion) && !CanMigrateJSON(b.JSONVersion, busJSONVersion) {
+		b.ModellingBusConnector.Reporter.Error("Cannot migrate between %s and %s.", busJSONVersion, b.JSONVersion)
+		return false
+	}
+
+	// Create the connector communicating in the JSON version of the bus
+	busConnector := CreateModellingBusArtefactConnector(b.ModellingBusConnector, busJSONVersion, b.ArtefactID)
+	b.busConnector = &busConnector
+
+	return true
+}
+
+// Communicating on the bus in the JSON version of the connector again (the default)
+func (b *TModellingBusArtefactConnector) UseNoJSONVersionMigration() {
+	b.busConnector = nil
+}
+
+// Getting the JSON version used on the bus, which differs from the JSON version of the connector in migrating mode
+func (b *TModellingBusArtefactConnector) BusJSONVersion() string {
+	if b.busConnector != nil {
+		return b.busConnector.JSONVersion
+	}
+
+	return b.JSONVersion
+}
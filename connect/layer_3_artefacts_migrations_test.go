@@ -0,0 +1,152 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Migrations (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+// Registering a migration that records the JSON version it migrated to
+func registerTestMigration(fromJSONVersion, toJSONVersion string) {
+	RegisterJSONMigration(fromJSONVersion, toJSONVersion, func(message []byte) ([]byte, error) {
+		return append(append(message, '>'), toJSONVersion...), nil
+	})
+}
+
+func TestMigrateJSON(t *testing.T) {
+	// The migrations form the graph a > b > c > d, a > e > d, with c > a closing a cycle, and a failing f > g
+	registerTestMigration("test-a", "test-b")
+	registerTestMigration("test-b", "test-c")
+	registerTestMigration("test-c", "test-d")
+	registerTestMigration("test-a", "test-e")
+	registerTestMigration("test-e", "test-d")
+	registerTestMigration("test-c", "test-a")
+	RegisterJSONMigration("test-f", "test-g", func([]byte) ([]byte, error) {
+		return nil, errors.New("failing migration")
+	})
+
+	tests := []struct {
+		name            string
+		fromJSONVersion string
+		toJSONVersion   string
+		migrated        string
+		ok              bool
+	}{
+		{"same version", "test-a", "test-a", "m", true},
+		{"direct", "test-a", "test-b", "m>test-b", true},
+		{"via other versions", "test-a", "test-c", "m>test-b>test-c", true},
+		{"shortest chain", "test-a", "test-d", "m>test-e>test-d", true},
+		{"via a cycle", "test-b", "test-e", "m>test-c>test-a>test-e", true},
+		{"no chain", "test-d", "test-a", "", false},
+		{"unknown version", "test-a", "test-x", "", false},
+		{"failing migration", "test-f", "test-g", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			migrated, err := MigrateJSON(test.fromJSONVersion, test.toJSONVersion, []byte("m"))
+			if ok := err == nil; ok != test.ok {
+				t.Fatalf("got error %v, want success %t", err, test.ok)
+			}
+			if string(migrated) != test.migrated {
+				t.Errorf("got %q, want %q", migrated, test.migrated)
+			}
+		})
+	}
+
+	if CanMigrateJSON("test-d", "test-a") {
+		t.Errorf("no migration from test-d to test-a should be found")
+	}
+}
+
+func TestRejectedInMigratingMode(t *testing.T) {
+	registerTestMigration("test-bus", "test-presented")
+
+	tests := []struct {
+		name    string
+		operate func(*TModellingBusArtefactConnector) bool
+	}{
+		{"post alternative", func(b *TModellingBusArtefactConnector) bool {
+			b.PostJSONArtefactAlternative("option", []byte(`{}`), true)
+			return len(b.AlternativeContents) > 0
+		}},
+		{"promote alternative", func(b *TModellingBusArtefactConnector) bool {
+			return b.PromoteJSONArtefactAlternative("option")
+		}},
+		{"discard alternative", func(b *TModellingBusArtefactConnector) bool {
+			return b.DiscardJSONArtefactAlternative("option")
+		}},
+		{"listen for alternatives", func(b *TModellingBusArtefactConnector) bool {
+			b.ListenForJSONArtefactAlternativePostings("agent", "artefact", func(string) {})
+			return false
+		}},
+		{"get the alternatives", func(b *TModellingBusArtefactConnector) bool {
+			return len(b.GetJSONArtefactAlternatives("agent", "artefact")) > 0
+		}},
+		{"get alternative", func(b *TModellingBusArtefactConnector) bool {
+			_, ok := b.GetJSONArtefactAlternative("agent", "artefact", "option")
+			return ok
+		}},
+		{"propose an update", func(b *TModellingBusArtefactConnector) bool {
+			return b.ProposeJSONArtefactUpdate("owner", "artefact", []byte(`{}`), true) != ""
+		}},
+		{"accept a proposal", func(b *TModellingBusArtefactConnector) bool {
+			return b.AcceptJSONArtefactProposal(TJSONArtefactProposal{})
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Count the reported errors, where each rejection reports one
+			reportedErrors := 0
+			connector := TModellingBusConnector{}
+			connector.Reporter = generics.CreateReporter(generics.ProgressLevelBasic, func(string) { reportedErrors++ }, func(string) {})
+			b := CreateModellingBusArtefactConnector(connector, "test-presented", "artefact")
+			if !b.UseJSONVersionMigration("test-bus") {
+				t.Fatalf("the migrating mode should be used")
+			}
+
+			if test.operate(&b) {
+				t.Errorf("the operation should not be performed in migrating mode")
+			}
+			if reportedErrors != 1 {
+				t.Errorf("got %d reported errors, want 1", reportedErrors)
+			}
+		})
+	}
+}
+
+func TestUseJSONVersionMigration(t *testing.T) {
+	registerTestMigration("test-bus", "test-presented")
+
+	b := createTestListener(t, `{}`)
+	b.JSONVersion = "test-presented"
+	if b.UseJSONVersionMigration("test-unrelated") {
+		t.Errorf("the migrating mode should not be used without migrations")
+	}
+	if b.rejectedInMigratingMode("test") || b.BusJSONVersion() != "test-presented" {
+		t.Errorf("without migrations, the connector should not be in migrating mode")
+	}
+
+	if !b.UseJSONVersionMigration("test-bus") || b.BusJSONVersion() != "test-bus" {
+		t.Errorf("got bus JSON version %s, want test-bus", b.BusJSONVersion())
+	}
+
+	b.UseNoJSONVersionMigration()
+	if b.BusJSONVersion() != "test-presented" {
+		t.Errorf("got bus JSON version %s after leaving migrating mode, want test-presented", b.BusJSONVersion())
+	}
+}
@@ -0,0 +1,390 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Proposals
+ *
+ * This component provides the functionality for multi-writer artefacts on the BIG Modelling Bus.
+ * Artefacts are always posted by their owning agent. Other agents can, however, propose updates to an artefact.
+ * Such a proposal is a JSON delta against the updated content of the artefact, as known to the proposing agent.
+ * The owner decides to accept or reject proposals. Accepted proposals are posted as an update of the artefact,
+ * while the decision is reported back to the proposing agent.
+ *
+ * Concurrency is handled optimistically. A proposal refers to the version of the artefact it is based on.
+ * When this is no longer the updated version at the owner side, e.g. since another proposal has been accepted
+ * in the meantime, the proposal is outdated, and will only be accepted if it can be merged without conflicts
+ * with the present updated version (using a three-way merge).
+ *
+ * Proposals are retained on the bus. Proposals that were posted before the owner started listening, and that
+ * have not been decided upon yet (i.e. the latest decision for the proposer concerns another proposal), are
+ * passed on to the owner when it starts listening for proposals.
+ *
+ * Topics involved:
+ * - <proposing agent>/artefacts/json/<artefact id>/<json version>/proposals/<owning agent>
+ * - <owning agent>/artefacts/json/<artefact id>/<json version>/decisions/<proposing agent>
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining constants
+ */
+
+const (
+	artefactProposalsPathElement = "proposals" // Artefact proposals path element
+	artefactDecisionsPathElement = "decisions" // Artefact decisions path element
+)
+
+/*
+ * Defining proposals and decisions
+ */
+
+type (
+	// A proposal for an update of an artefact, as received by the owner
+	TJSONArtefactProposal struct {
+		ProposerID string     // The agent proposing the update
+		ArtefactID string     // The artefact the update is proposed for
+		Delta      TJSONDelta // The proposed delta, against the updated content at the base timestamps
+		Outdated   bool       // Whether the proposal is based on an outdated version of the artefact
+		Competing  []string   // The proposers of pending proposals based on the same version of the artefact
+	}
+
+	// The decision of the owner on a proposal
+	TJSONArtefactProposalDecision struct {
+		ProposalTimestamp string `json:"proposal timestamp"`         // The timestamp of the proposal
+		Accepted          bool   `json:"accepted"`                   // Whether the proposal was accepted
+		Reason            string `json:"reason,omitempty"`           // The reason for a rejection
+		ResultTimestamp   string `json:"result timestamp,omitempty"` // The timestamp of the update posted for an accepted proposal
+	}
+)
+
+/*
+ * Defining topic paths
+ */
+
+// Defining topic paths for json artefact proposals to a given owner
+func (b *TModellingBusArtefactConnector) jsonArtefactsProposalsTopicPath(artefactID, ownerID string) string {
+	return b.jsonArtefactsTopicPath(artefactID) +
+		"/" + artefactProposalsPathElement +
+		"/" + ownerID
+}
+
+// Defining topic paths for json artefact decisions to a given proposer
+func (b *TModellingBusArtefactConnector) jsonArtefactsDecisionsTopicPath(artefactID, proposerID string) string {
+	return b.jsonArtefactsTopicPath(artefactID) +
+		"/" + artefactDecisionsPathElement +
+		"/" + proposerID
+}
+
+/*
+ * Handling proposals
+ */
+
+// Getting the key used to keep track of pending proposals
+func (p TJSONArtefactProposal) key() string {
+	return p.ProposerID + "/" + p.Delta.Timestamp
+}
+
+// Checking whether the proposal is based on the given versions of the artefact
+func (p TJSONArtefactProposal) isBasedOn(currentTimestamp, updatedTimestamp string) bool {
+	return p.Delta.CurrentTimestamp == currentTimestamp && p.Delta.PredecessorTimestamp == updatedTimestamp
+}
+
+// Receiving a proposal from a proposing agent
+func (b *TModellingBusArtefactConnector) receiveJSONArtefactProposal(proposerID string, proposalJSON []byte) (TJSONArtefactProposal, bool) {
+	// Unmarshal the proposed delta
+	delta, ok := b.jsonDeltaFromJSON(proposalJSON)
+	if !ok {
+		return TJSONArtefactProposal{}, false
+	}
+
+	// Define the proposal
+	proposal := TJSONArtefactProposal{}
+	proposal.ProposerID = proposerID
+	proposal.ArtefactID = b.ArtefactID
+	proposal.Delta = delta
+	proposal.Outdated = !proposal.isBasedOn(b.CurrentTimestamp, b.UpdatedTimestamp)
+
+	// Proposals are received by the MQTT client, so guard the pending proposals
+	b.pendingProposalsMutex.Lock()
+	defer b.pendingProposalsMutex.Unlock()
+
+	// A proposal that is already pending has been received before
+	if _, pending := b.pendingProposals[proposal.key()]; pending {
+		return TJSONArtefactProposal{}, false
+	}
+
+	// Detect competing proposals, i.e. pending proposals based on the same version
+	for _, pendingProposal := range b.pendingProposals {
+		if pendingProposal.isBasedOn(delta.CurrentTimestamp, delta.PredecessorTimestamp) {
+			proposal.Competing = append(proposal.Competing, pendingProposal.ProposerID)
+		}
+	}
+
+	// Keep track of the proposal until it is decided upon
+	b.pendingProposals[proposal.key()] = proposal
+
+	return proposal, true
+}
+
+// Posting the decision on a proposal
+func (b *TModellingBusArtefactConnector) postJSONArtefactProposalDecision(proposal TJSONArtefactProposal, decision TJSONArtefactProposalDecision) {
+	// The proposal is no longer pending
+	b.pendingProposalsMutex.Lock()
+	delete(b.pendingProposals, proposal.key())
+	b.pendingProposalsMutex.Unlock()
+
+	// Convert the decision to JSON
+	decisionJSON, err := json.Marshal(decision)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong JSONing the proposal decision:", err) {
+		return
+	}
+
+	// Post the decision as a streamed event
+	b.ModellingBusConnector.postJSONAsStreamed(b.jsonArtefactsDecisionsTopicPath(b.ArtefactID, proposal.ProposerID), decisionJSON, generics.GetTimestamp())
+}
+
+// Getting the latest decision posted by us for the given proposer, if any
+func (b *TModellingBusArtefactConnector) latestJSONArtefactProposalDecision(proposerID string) (TJSONArtefactProposalDecision, bool) {
+	// Get the latest decision from the bus
+	message := b.ModellingBusConnector.modellingBusEventsConnector.messageFromEvent(b.ModellingBusConnector.agentID, b.jsonArtefactsDecisionsTopicPath(b.ArtefactID, proposerID))
+	if len(message) == 0 {
+		return TJSONArtefactProposalDecision{}, false
+	}
+	decisionJSON, _ := b.ModellingBusConnector.splitStreamedEventFromMessage(message)
+
+	// Unmarshal the decision
+	decision := TJSONArtefactProposalDecision{}
+	err := json.Unmarshal(decisionJSON, &decision)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong unJSONing the proposal decision:", err) {
+		return TJSONArtefactProposalDecision{}, false
+	}
+
+	return decision, true
+}
+
+// Receiving the proposals that were posted before we started listening, and that have not been decided upon yet.
+// These are retained on the bus, but are not passed on as events when we start listening.
+func (b *TModellingBusArtefactConnector) receiveRetainedJSONArtefactProposals(handler func(TJSONArtefactProposal)) {
+	ownerID := b.ModellingBusConnector.agentID
+	proposalsTopicPath := b.jsonArtefactsProposalsTopicPath(b.ArtefactID, ownerID)
+	for _, proposerID := range b.ModellingBusConnector.modellingBusEventsConnector.agentsWithEventsOn(proposalsTopicPath) {
+		// Get the retained proposal
+		proposalJSON, proposalTimestamp := b.ModellingBusConnector.getJSON(proposerID, proposalsTopicPath)
+		if len(proposalJSON) == 0 {
+			continue
+		}
+
+		// Skip the proposal when it has been decided upon already
+		if decision, decided := b.latestJSONArtefactProposalDecision(proposerID); decided && decision.ProposalTimestamp == proposalTimestamp {
+			continue
+		}
+
+		if proposal, ok := b.receiveJSONArtefactProposal(proposerID, proposalJSON); ok {
+			handler(proposal)
+		}
+	}
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+/*
+ * Proposing updates
+ */
+
+// Proposing an update to an artefact owned by another agent.
+// The artefact should be followed (e.g. using GetJSONArtefactUpdate or the listening functions), as the proposal
+// is a delta against the updated content as known to this connector.
+// This returns the timestamp of the proposal, which is also used in the decision on the proposal.
+func (b *TModellingBusArtefactConnector) ProposeJSONArtefactUpdate(ownerID, artefactID string, proposedStateJSON []byte, okJSONing bool) string {
+	// If not ok, or not valid, then do not proceed
+	if !okJSONing || b.rejectedInMigratingMode("propose an update") || b.maybeReportInvalidJSONArtefactContent(proposedStateJSON) {
+		return ""
+	}
+
+	// Create the delta against the updated content
+	delta, ok := b.createJSONDelta(b.UpdatedContent, proposedStateJSON)
+	if !ok {
+		return ""
+	}
+	delta.PredecessorTimestamp = b.UpdatedTimestamp
+
+	// Post the proposal
+	b.postJSONDeltaObject(b.jsonArtefactsProposalsTopicPath(artefactID, ownerID), delta)
+
+	return delta.Timestamp
+}
+
+// Listening for the decisions of the owner of an artefact on our proposals
+func (b *TModellingBusArtefactConnector) ListenForJSONArtefactProposalDecisions(ownerID, artefactID string, handler func(TJSONArtefactProposalDecision)) {
+	if b.rejectedInMigratingMode("listen for proposal decisions") {
+		return
+	}
+
+	proposerID := b.ModellingBusConnector.agentID
+	b.ModellingBusConnector.listenForStreamedPostings(ownerID, b.jsonArtefactsDecisionsTopicPath(artefactID, proposerID), func(decisionJSON []byte, _ string) {
+		// Unmarshal the decision
+		decision := TJSONArtefactProposalDecision{}
+		err := json.Unmarshal(decisionJSON, &decision)
+
+		// Handle potential errors
+		if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong unJSONing the proposal decision:", err) {
+			return
+		}
+
+		handler(decision)
+	})
+}
+
+/*
+ * Deciding on proposals
+ */
+
+// Listening for proposals from other agents for the artefact owned by this agent.
+// This turns the artefact into a multi-writer artefact.
+// Proposals posted before we started listening, and not yet decided upon, are passed on as well.
+// Note: the latter requires the modelling bus connector not to be created in posting only mode.
+func (b *TModellingBusArtefactConnector) ListenForJSONArtefactProposals(handler func(TJSONArtefactProposal)) {
+	if b.rejectedInMigratingMode("listen for proposals") {
+		return
+	}
+
+	ownerID := b.ModellingBusConnector.agentID
+	b.ModellingBusConnector.listenForJSONFilePostingsFromAnyAgent(b.jsonArtefactsProposalsTopicPath(b.ArtefactID, ownerID), func(proposerID string, proposalJSON []byte, _ string) {
+		if proposal, ok := b.receiveJSONArtefactProposal(proposerID, proposalJSON); ok {
+			handler(proposal)
+		}
+	})
+
+	// Pass on the retained proposals that have not been decided upon yet
+	b.receiveRetainedJSONArtefactProposals(handler)
+}
+
+// Checking whether a proposal is (still) based on the present updated version of the artefact
+func (b *TModellingBusArtefactConnector) IsJSONArtefactProposalOutdated(proposal TJSONArtefactProposal) bool {
+	return !proposal.isBasedOn(b.CurrentTimestamp, b.UpdatedTimestamp)
+}
+
+// Merging an outdated proposal with the present updated content
+func (b *TModellingBusArtefactConnector) mergeJSONArtefactProposal(proposal TJSONArtefactProposal) (json.RawMessage, string, bool) {
+	// Reconstruct the version of the artefact the proposal is based on
+	baseContent, ok := b.GetJSONArtefactAt(b.ModellingBusConnector.agentID, b.ArtefactID, proposal.Delta.PredecessorTimestamp)
+	if !ok {
+		return nil, "the version the proposal is based on could not be found", false
+	}
+
+	// Apply the proposed delta to the version it is based on
+	proposedContent, err := proposal.Delta.Apply(baseContent)
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Applying the proposed diff patch did not work:", err) {
+		return nil, "the proposal could not be applied", false
+	}
+
+	// Merge the proposed content with the present updated content
+	mergedContent, conflicts, err := generics.JSONMerge3(baseContent, b.UpdatedContent, proposedContent)
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Merging the proposal did not work:", err) {
+		return nil, "the proposal could not be merged", false
+	}
+
+	// Conflicting changes cannot be accepted
+	if len(conflicts) > 0 {
+		conflictingPaths := []string{}
+		for _, conflict := range conflicts {
+			conflictingPaths = append(conflictingPaths, conflict.Path)
+		}
+
+		return nil, "the proposal conflicts with a more recent update at " + strings.Join(conflictingPaths, ", "), false
+	}
+
+	return mergedContent, "", true
+}
+
+// Accepting a proposal, which is then posted as an update of the artefact.
+// An outdated proposal is merged with the present updated content, and rejected when this leads to conflicts.
+func (b *TModellingBusArtefactConnector) AcceptJSONArtefactProposal(proposal TJSONArtefactProposal) bool {
+	if b.rejectedInMigratingMode("accept a proposal") {
+		return false
+	}
+
+	var (
+		proposedContent json.RawMessage // The content resulting from the proposal
+		reason          string          // The reason for not being able to accept the proposal
+		ok              bool            // Whether the proposal can be accepted
+	)
+
+	if b.IsJSONArtefactProposalOutdated(proposal) {
+		// Merge outdated proposals with the present updated content
+		proposedContent, reason, ok = b.mergeJSONArtefactProposal(proposal)
+	} else {
+		// Apply the proposed delta to the updated content
+		var err error
+		proposedContent, err = proposal.Delta.Apply(b.UpdatedContent)
+		ok = !b.ModellingBusConnector.Reporter.MaybeReportError("Applying the proposed diff patch did not work:", err)
+		reason = "the proposal could not be applied"
+	}
+
+	// Reject the proposal if it cannot be accepted
+	if !ok {
+		b.RejectJSONArtefactProposal(proposal, reason)
+		return false
+	}
+
+	// Publish the accepted result as an update
+	b.PostJSONArtefactUpdate(proposedContent, true)
+
+	// Report the decision
+	decision := TJSONArtefactProposalDecision{}
+	decision.ProposalTimestamp = proposal.Delta.Timestamp
+	decision.Accepted = true
+	decision.ResultTimestamp = b.UpdatedTimestamp
+	b.postJSONArtefactProposalDecision(proposal, decision)
+
+	return true
+}
+
+// Rejecting a proposal
+func (b *TModellingBusArtefactConnector) RejectJSONArtefactProposal(proposal TJSONArtefactProposal, reason string) {
+	if b.rejectedInMigratingMode("reject a proposal") {
+		return
+	}
+
+	// Report the decision
+	decision := TJSONArtefactProposalDecision{}
+	decision.ProposalTimestamp = proposal.Delta.Timestamp
+	decision.Accepted = false
+	decision.Reason = reason
+	b.postJSONArtefactProposalDecision(proposal, decision)
+}
+
+// Getting the proposals that have not yet been decided upon
+func (b *TModellingBusArtefactConnector) PendingJSONArtefactProposals() []TJSONArtefactProposal {
+	b.pendingProposalsMutex.Lock()
+	defer b.pendingProposalsMutex.Unlock()
+
+	proposals := []TJSONArtefactProposal{}
+	for _, proposal := range b.pendingProposals {
+		proposal.Outdated = b.IsJSONArtefactProposalOutdated(proposal)
+		proposals = append(proposals, proposal)
+	}
+
+	return proposals
+}
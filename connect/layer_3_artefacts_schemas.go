@@ -0,0 +1,233 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Schemas
+ *
+ * This component provides the validation of JSON artefacts against JSON Schemas on the BIG Modelling Bus.
+ * JSON Schemas are registered per JSON version (such as "cdm-v1.0-v1.0"). For JSON versions with a registered
+ * schema, the artefact connector validates:
+ * - on the posting side, the states, updates, considerings, alternatives and proposals before posting them;
+ * - on the listening side, the received states, as well as the results of applying received deltas.
+ *
+ * Postings that are rejected by a listener are reported back to the poster, by way of a rejection event that
+ * lists the validation errors.
+ *
+ * Topics involved:
+ * - <listening agent>/artefacts/json/<artefact id>/<json version>/rejections/<posting agent>
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining constants
+ */
+
+const (
+	artefactRejectionsPathElement = "rejections" // Artefact rejections path element
+)
+
+/*
+ * Defining rejections
+ */
+
+type (
+	// The rejection of a posting by a listener, since it does not conform to the JSON Schema of its JSON version
+	TJSONArtefactRejection struct {
+		ListenerID       string                          `json:"-"`                 // The agent rejecting the posting (as received by the poster)
+		ArtefactID       string                          `json:"artefact id"`       // The artefact of the rejected posting
+		JSONVersion      string                          `json:"json version"`      // The JSON version of the rejected posting
+		Layer            string                          `json:"layer"`             // The layer (state, update or considering) of the rejected posting
+		PostingTimestamp string                          `json:"posting timestamp"` // The timestamp of the rejected posting
+		Errors           []generics.TJSONValidationError `json:"errors"`            // The validation errors found
+	}
+)
+
+/*
+ * Registering JSON schemas
+ */
+
+var (
+	jsonSchemas      = map[string]generics.TJSONSchema{} // The JSON schemas per JSON version
+	jsonSchemasMutex sync.RWMutex                        // Guarding the access to the JSON schemas
+)
+
+// Getting the JSON schema for a JSON version, if any
+func jsonSchemaFor(jsonVersion string) (generics.TJSONSchema, bool) {
+	jsonSchemasMutex.RLock()
+	defer jsonSchemasMutex.RUnlock()
+
+	schema, registered := jsonSchemas[jsonVersion]
+
+	return schema, registered
+}
+
+/*
+ * Defining topic paths
+ */
+
+// Defining topic paths for json artefact rejections to a given poster
+func (b *TModellingBusArtefactConnector) jsonArtefactsRejectionsTopicPath(artefactID, posterID string) string {
+	return b.jsonArtefactsTopicPath(artefactID) +
+		"/" + artefactRejectionsPathElement +
+		"/" + posterID
+}
+
+/*
+ * Validating artefacts
+ */
+
+// Validating the content of the artefact against the JSON schema of its JSON version.
+// The validation errors found are kept, so they can be reported.
+func (b *TModellingBusArtefactConnector) validJSONArtefactContent(content []byte) bool {
+	b.validationErrors = []generics.TJSONValidationError{}
+
+	// Without a registered schema, all content is valid
+	schema, registered := jsonSchemaFor(b.JSONVersion)
+	if !registered {
+		return true
+	}
+
+	// Validate the content
+	validationErrors, err := schema.Validate(content)
+	if err != nil {
+		validationErrors = append(validationErrors, generics.TJSONValidationError{Path: "", Message: err.Error()})
+	}
+	b.validationErrors = validationErrors
+
+	return len(b.validationErrors) == 0
+}
+
+// Checking, before posting, whether the content of the artefact is invalid, reporting the validation errors if so
+func (b *TModellingBusArtefactConnector) maybeReportInvalidJSONArtefactContent(content []byte) bool {
+	if b.validJSONArtefactContent(content) {
+		return false
+	}
+
+	// Report the validation errors
+	b.ModellingBusConnector.Reporter.Error("The content of artefact %s does not conform to the JSON schema of %s.", b.ArtefactID, b.JSONVersion)
+	for _, validationError := range b.validationErrors {
+		b.ModellingBusConnector.Reporter.Error("- %s: %s", validationError.Path, validationError.Message)
+	}
+
+	return true
+}
+
+// Checking whether the latest received posting has been found to be invalid
+func (b *TModellingBusArtefactConnector) hasInvalidJSONArtefactContent() bool {
+	return len(b.validationErrors) > 0
+}
+
+// Rejecting an invalid posting of the given agent, reporting the validation errors back to the poster
+func (b *TModellingBusArtefactConnector) rejectJSONArtefactPosting(agentID, artefactID, layer, postingTimestamp string) {
+	// Define the rejection
+	rejection := TJSONArtefactRejection{}
+	rejection.ArtefactID = artefactID
+	rejection.JSONVersion = b.JSONVersion
+	rejection.Layer = layer
+	rejection.PostingTimestamp = postingTimestamp
+	rejection.Errors = b.validationErrors
+
+	// Report the rejection
+	b.ModellingBusConnector.Reporter.Progress(generics.ProgressLevelBasic, "Rejected the %s of artefact %s by %s, as it does not conform to the JSON schema of %s.", layer, artefactID, agentID, b.JSONVersion)
+
+	// Convert the rejection to JSON
+	rejectionJSON, err := json.Marshal(rejection)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong JSONing the rejection:", err) {
+		return
+	}
+
+	// Post the rejection as a streamed event
+	b.ModellingBusConnector.postJSONAsStreamed(b.jsonArtefactsRejectionsTopicPath(artefactID, agentID), rejectionJSON, generics.GetTimestamp())
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Registering the JSON schema for a JSON version.
+// From then on, artefact connectors for this JSON version validate the content they post and receive.
+func RegisterJSONSchema(jsonVersion string, schemaJSON []byte) error {
+	// Compile the schema
+	schema, err := generics.CompileJSONSchema(jsonVersion+".schema.json", schemaJSON)
+	if err != nil {
+		return err
+	}
+
+	// Register it
+	jsonSchemasMutex.Lock()
+	defer jsonSchemasMutex.Unlock()
+
+	jsonSchemas[jsonVersion] = schema
+
+	return nil
+}
+
+// Checking whether a JSON schema has been registered for a JSON version
+func HasJSONSchema(jsonVersion string) bool {
+	_, registered := jsonSchemaFor(jsonVersion)
+
+	return registered
+}
+
+// Validating a JSON against the JSON schema registered for a JSON version, returning the validation errors found
+func ValidateJSON(jsonVersion string, message []byte) []generics.TJSONValidationError {
+	// Without a registered schema, all JSONs are valid
+	schema, registered := jsonSchemaFor(jsonVersion)
+	if !registered {
+		return []generics.TJSONValidationError{}
+	}
+
+	// Validate the JSON
+	validationErrors, err := schema.Validate(message)
+	if err != nil {
+		validationErrors = append(validationErrors, generics.TJSONValidationError{Path: "", Message: err.Error()})
+	}
+
+	return validationErrors
+}
+
+// Getting the validation errors of the latest content validated by this connector
+func (b *TModellingBusArtefactConnector) ValidationErrors() []generics.TJSONValidationError {
+	return b.validationErrors
+}
+
+// Listening for rejections, by any listening agent, of the postings of this agent for the artefact
+func (b *TModellingBusArtefactConnector) ListenForJSONArtefactRejections(handler func(TJSONArtefactRejection)) {
+	// In migrating mode, the postings, and thus their rejections, are in the JSON version of the bus
+	if b.busConnector != nil {
+		b.migratingConnector().ListenForJSONArtefactRejections(handler)
+		return
+	}
+
+	posterID := b.ModellingBusConnector.agentID
+	b.ModellingBusConnector.listenForStreamedPostingsFromAnyAgent(b.jsonArtefactsRejectionsTopicPath(b.ArtefactID, posterID), func(listenerID string, rejectionJSON []byte, _ string) {
+		// Unmarshal the rejection
+		rejection := TJSONArtefactRejection{}
+		err := json.Unmarshal(rejectionJSON, &rejection)
+
+		// Handle potential errors
+		if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong unJSONing the rejection:", err) {
+			return
+		}
+		rejection.ListenerID = listenerID
+
+		handler(rejection)
+	})
+}
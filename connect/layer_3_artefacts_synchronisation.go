@@ -0,0 +1,114 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Synchronisation
+ *
+ * This component provides the functionality for artefact listeners to stay in sync with the BIG Modelling Bus.
+ * When a posted delta cannot be applied to the known state of an artefact, the listener is out of sync.
+ * It will then automatically fetch the current state, update and considering from the bus, and report
+ * this by way of an out-of-sync event.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining out-of-sync events
+ */
+
+type (
+	TOutOfSyncEvent struct {
+		AgentID        string // The agent posting the artefact
+		ArtefactID     string // The artefact that got out of sync
+		Layer          string // The layer (state, update or considering) of the posting that could not be applied
+		Reason         string // The reason why the posting could not be applied
+		Resynchronised bool   // Whether the listener managed to re-synchronise with the bus
+	}
+)
+
+/*
+ * Defining the outcomes of receiving postings
+ */
+
+const (
+	jsonArtefactPostingApplied   = "applied"     // The posting has been applied
+	jsonArtefactPostingRejected  = "rejected"    // The posting has been rejected, as its content is invalid
+	jsonArtefactPostingOutOfSync = "out of sync" // The posting could not be applied to the known state, so re-synchronisation is needed
+)
+
+/*
+ * Synchronising with the bus
+ */
+
+// Marking that the listener is in sync with the bus
+func (b *TModellingBusArtefactConnector) markInSync() {
+	b.outOfSync = false
+	b.syncProblem = ""
+}
+
+// Receiving an update or considering posting, which is applied using the given function
+func (b *TModellingBusArtefactConnector) receiveJSONArtefactPosting(apply func([]byte, string) bool, json []byte, timestamp string) string {
+	switch {
+	case apply(json, timestamp):
+		return jsonArtefactPostingApplied
+	case b.hasInvalidJSONArtefactContent():
+		return jsonArtefactPostingRejected
+	default:
+		return jsonArtefactPostingOutOfSync
+	}
+}
+
+// Re-synchronising with the bus after a posting on the given layer could not be applied.
+// This returns whether the re-synchronisation was successful.
+func (b *TModellingBusArtefactConnector) resynchroniseJSONArtefact(agentID, artefactID, layer string) bool {
+	// Define the out-of-sync event
+	event := TOutOfSyncEvent{}
+	event.AgentID = agentID
+	event.ArtefactID = artefactID
+	event.Layer = layer
+	event.Reason = b.syncProblem
+
+	// Report the problem
+	b.outOfSync = true
+	b.ModellingBusConnector.Reporter.Progress(generics.ProgressLevelBasic, "Artefact %s got out of sync, as %s. Re-synchronising.", artefactID, event.Reason)
+
+	// Fetch the current state, and then the latest update and considering
+	event.Resynchronised = b.getJSONArtefactConsidering(agentID, artefactID)
+	if event.Resynchronised {
+		b.markInSync()
+	} else {
+		b.ModellingBusConnector.Reporter.Error("Could not re-synchronise artefact %s, as %s.", artefactID, b.syncProblem)
+	}
+
+	// Inform the out-of-sync handler
+	if b.outOfSyncHandler != nil {
+		b.outOfSyncHandler(event)
+	}
+
+	return event.Resynchronised
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Setting the handler to be called when the listener got out of sync with the bus
+func (b *TModellingBusArtefactConnector) SetOutOfSyncHandler(handler func(TOutOfSyncEvent)) {
+	b.outOfSyncHandler = handler
+}
+
+// Checking whether the listener is in sync with the bus
+func (b *TModellingBusArtefactConnector) IsInSync() bool {
+	return !b.outOfSync
+}
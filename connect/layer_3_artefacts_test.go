@@ -0,0 +1,354 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefacts (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+// A history of states and updates of an artefact, as listed on the repository
+type tTestHistory struct {
+	states   tRepositoryVersions // The listed states
+	updates  tRepositoryVersions // The listed updates
+	contents map[string][]byte   // The contents of the listed versions, by their file paths
+}
+
+// Creating an empty history
+func createTestHistory() tTestHistory {
+	return tTestHistory{
+		states:   tRepositoryVersions{filePaths: map[string]string{}},
+		updates:  tRepositoryVersions{filePaths: map[string]string{}},
+		contents: map[string][]byte{},
+	}
+}
+
+// Adding a version to the history
+func (h tTestHistory) add(versions tRepositoryVersions, layer, timestamp string, content []byte) {
+	filePath := layer + "/" + timestamp + ".json"
+	versions.filePaths[timestamp] = filePath
+	h.contents[filePath] = content
+}
+
+// Getting the content of a listed version
+func (h tTestHistory) getVersion(versions tRepositoryVersions, timestamp string) ([]byte, bool) {
+	content, found := h.contents[versions.filePaths[timestamp]]
+
+	return content, found
+}
+
+// Creating an artefact connector, without a connection to the modelling bus, that logs the errors it reports
+func createTestArtefactConnector(t *testing.T) *TModellingBusArtefactConnector {
+	b := TModellingBusArtefactConnector{}
+	b.ModellingBusConnector.Reporter = generics.CreateReporter(generics.ProgressLevelBasic, func(message string) { t.Log(message) }, func(string) {})
+
+	return &b
+}
+
+// Creating the JSON of a delta between two contents, against the given state, and, for chained deltas, the given predecessor
+func testDeltaJSON(t *testing.T, oldContent, newContent, stateTimestamp, predecessorTimestamp string) []byte {
+	t.Helper()
+
+	b := createTestArtefactConnector(t)
+	b.CurrentTimestamp = stateTimestamp
+	delta, ok := b.createJSONDelta([]byte(oldContent), []byte(newContent))
+	if !ok {
+		t.Fatalf("the delta could not be created")
+	}
+	delta.PredecessorTimestamp = predecessorTimestamp
+
+	deltaJSON, err := json.Marshal(delta)
+	if err != nil {
+		t.Fatalf("the delta could not be converted to JSON: %v", err)
+	}
+
+	return deltaJSON
+}
+
+// Creating an artefact connector, without a connection to the modelling bus, that received the given state
+func createTestListener(t *testing.T, state string) *TModellingBusArtefactConnector {
+	connector := TModellingBusConnector{}
+	connector.Reporter = createTestArtefactConnector(t).ModellingBusConnector.Reporter
+	b := CreateModellingBusArtefactConnector(connector, "1.0", "artefact")
+	b.updateCurrentJSONArtefact([]byte(state), generics.GetTimestamp())
+
+	return &b
+}
+
+// Creating the JSON of an action (commit or discard) on the given content, against the given state and predecessor
+func testActionJSON(t *testing.T, action, content, stateTimestamp, predecessorTimestamp string) []byte {
+	t.Helper()
+
+	deltaJSON := testDeltaJSON(t, content, content, stateTimestamp, predecessorTimestamp)
+	delta := TJSONDelta{}
+	if err := json.Unmarshal(deltaJSON, &delta); err != nil {
+		t.Fatalf("the delta could not be read: %v", err)
+	}
+	delta.Action = action
+
+	actionJSON, err := json.Marshal(delta)
+	if err != nil {
+		t.Fatalf("the action could not be converted to JSON: %v", err)
+	}
+
+	return actionJSON
+}
+
+// Checking whether two JSON documents are equal, disregarding formatting and the order of members
+func assertEqualJSON(t *testing.T, got, want []byte) {
+	t.Helper()
+
+	gotHash, gotErr := generics.JSONHash(got)
+	wantHash, wantErr := generics.JSONHash(want)
+	if gotErr != nil || wantErr != nil || gotHash != wantHash {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestCreateJSONDelta(t *testing.T) {
+	tests := []struct {
+		name        string
+		deltaFormat string
+		oldState    string
+		newState    string
+		format      string
+	}{
+		{"json patch", JSONDeltaFormatPatch, `{"a":1}`, `{"a":2}`, JSONDeltaFormatPatch},
+		{"json merge patch", JSONDeltaFormatMergePatch, `{"a":1}`, `{"a":2,"b":{"c":3}}`, JSONDeltaFormatMergePatch},
+		{"json merge patch removing a member", JSONDeltaFormatMergePatch, `{"a":1,"b":2}`, `{"a":1}`, JSONDeltaFormatMergePatch},
+		{"json merge patch setting null", JSONDeltaFormatMergePatch, `{"a":1}`, `{"a":null}`, JSONDeltaFormatPatch},
+		{"json merge patch with nested null", JSONDeltaFormatMergePatch, `{"o":{}}`, `{"o":{"l":null}}`, JSONDeltaFormatPatch},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := TModellingBusArtefactConnector{}
+			b.UseDeltaFormat(test.deltaFormat)
+
+			delta, ok := b.createJSONDelta([]byte(test.oldState), []byte(test.newState))
+			if !ok {
+				t.Fatalf("the delta could not be created")
+			}
+			if delta.Format != test.format {
+				t.Errorf("got format %q, want %q", delta.Format, test.format)
+			}
+
+			// Applying the delta should result in the new state
+			result, err := delta.Apply([]byte(test.oldState))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resultHash, _ := generics.JSONHash(result)
+			if resultHash != delta.ResultHash {
+				t.Errorf("got %s, want %s", result, test.newState)
+			}
+		})
+	}
+}
+
+func TestLatestTimestampUntil(t *testing.T) {
+	timestamps := []string{"2026-10-18-12-00-00-00", "2026-10-18-12-00-00-05", "2026-10-18-12-00-00-100"}
+
+	tests := []struct {
+		name      string
+		timestamp string
+		latest    string
+		found     bool
+	}{
+		{"before the first version", "2026-10-18-11-59-59-00", "", false},
+		{"exact match", "2026-10-18-12-00-00-05", "2026-10-18-12-00-00-05", true},
+		{"between versions", "2026-10-18-12-00-00-99", "2026-10-18-12-00-00-05", true},
+		{"after the last version", "2026-10-18-12-00-01-00", "2026-10-18-12-00-00-100", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latest, found := latestTimestampUntil(timestamps, test.timestamp)
+			if latest != test.latest || found != test.found {
+				t.Errorf("got %q, %t, want %q, %t", latest, found, test.latest, test.found)
+			}
+		})
+	}
+}
+
+func TestReplayJSONArtefactVersions(t *testing.T) {
+	const (
+		state1      = "2026-10-18-12-00-00-00"
+		update1     = "2026-10-18-12-00-00-01"
+		update2     = "2026-10-18-12-00-00-02"
+		state2      = "2026-10-18-12-00-00-03"
+		staleUpdate = "2026-10-18-12-00-00-04"
+		update3     = "2026-10-18-12-00-00-05"
+	)
+
+	// Cumulative updates against two states, where one of the updates is against the earlier state
+	history := createTestHistory()
+	history.add(history.states, "state", state1, []byte(`{"a":1}`))
+	history.add(history.updates, "update", update1, testDeltaJSON(t, `{"a":1}`, `{"a":2}`, state1, ""))
+	history.add(history.updates, "update", update2, testDeltaJSON(t, `{"a":1}`, `{"a":3,"b":1}`, state1, ""))
+	history.add(history.states, "state", state2, []byte(`{"a":10}`))
+	history.add(history.updates, "update", staleUpdate, testDeltaJSON(t, `{"a":1}`, `{"a":99}`, state1, ""))
+	history.add(history.updates, "update", update3, testDeltaJSON(t, `{"a":10}`, `{"a":11}`, state2, ""))
+
+	tests := []struct {
+		name             string
+		timestamp        string
+		content          string
+		stateTimestamp   string
+		updatedTimestamp string
+		ok               bool
+	}{
+		{"before the first state", "2026-10-18-11-59-59-00", "", "", "", false},
+		{"at the first state", state1, `{"a":1}`, state1, state1, true},
+		{"at an update", update1, `{"a":2}`, state1, update1, true},
+		{"between updates", "2026-10-18-12-00-00-02", `{"a":3,"b":1}`, state1, update2, true},
+		{"ignoring an update against an earlier state", staleUpdate, `{"a":10}`, state2, state2, true},
+		{"after the last update", "2026-10-18-12-00-01-00", `{"a":11}`, state2, update3, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := createTestArtefactConnector(t)
+			content, stateTimestamp, updatedTimestamp, ok := b.replayJSONArtefactVersions("artefact", test.timestamp, history.states, history.updates, history.getVersion)
+			if ok != test.ok {
+				t.Fatalf("got %t, want %t", ok, test.ok)
+			}
+			if !ok {
+				return
+			}
+
+			if stateTimestamp != test.stateTimestamp || updatedTimestamp != test.updatedTimestamp {
+				t.Errorf("got timestamps %s, %s, want %s, %s", stateTimestamp, updatedTimestamp, test.stateTimestamp, test.updatedTimestamp)
+			}
+			assertEqualJSON(t, content, []byte(test.content))
+		})
+	}
+}
+
+func TestReplayChainedJSONArtefactVersions(t *testing.T) {
+	const (
+		state   = "2026-10-18-12-00-00-00"
+		update1 = "2026-10-18-12-00-00-01"
+		update2 = "2026-10-18-12-00-00-02"
+		update3 = "2026-10-18-12-00-00-03"
+	)
+
+	// A chain of updates, where each update is a delta against its predecessor
+	history := createTestHistory()
+	history.add(history.states, "state", state, []byte(`{"a":1}`))
+	history.add(history.updates, "update", update1, testDeltaJSON(t, `{"a":1}`, `{"a":1,"b":2}`, state, state))
+	history.add(history.updates, "update", update2, testDeltaJSON(t, `{"a":1,"b":2}`, `{"b":2}`, state, update1))
+	history.add(history.updates, "update", update3, testDeltaJSON(t, `{"b":2}`, `{"b":3,"c":4}`, state, update2))
+
+	tests := []struct {
+		timestamp string
+		content   string
+	}{
+		{state, `{"a":1}`},
+		{update1, `{"a":1,"b":2}`},
+		{update2, `{"b":2}`},
+		{update3, `{"b":3,"c":4}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.timestamp, func(t *testing.T) {
+			b := createTestArtefactConnector(t)
+			content, _, updatedTimestamp, ok := b.replayJSONArtefactVersions("artefact", test.timestamp, history.states, history.updates, history.getVersion)
+			if !ok {
+				t.Fatalf("the chain of updates could not be replayed")
+			}
+			if updatedTimestamp != test.timestamp {
+				t.Errorf("got timestamp %s, want %s", updatedTimestamp, test.timestamp)
+			}
+			assertEqualJSON(t, content, []byte(test.content))
+		})
+	}
+
+	// A chain missing an update cannot be replayed
+	brokenHistory := createTestHistory()
+	brokenHistory.add(brokenHistory.states, "state", state, []byte(`{"a":1}`))
+	brokenHistory.add(brokenHistory.updates, "update", update1, testDeltaJSON(t, `{"a":1}`, `{"a":2}`, state, state))
+	brokenHistory.add(brokenHistory.updates, "update", update3, testDeltaJSON(t, `{"a":3}`, `{"a":4}`, state, update2))
+
+	b := createTestArtefactConnector(t)
+	if _, _, _, ok := b.replayJSONArtefactVersions("artefact", update3, brokenHistory.states, brokenHistory.updates, brokenHistory.getVersion); ok {
+		t.Errorf("a broken chain of updates should not be replayed")
+	}
+}
+
+func TestNeedsRebasing(t *testing.T) {
+	tests := []struct {
+		name                 string
+		maxUpdates           int
+		maxDeltaSize         int
+		chainedUpdatesPosted int
+		operations           string
+		rebase               bool
+	}{
+		{"unlimited", 0, 0, 100, `[{"op":"add","path":"/a","value":1}]`, false},
+		{"below the maximum number of updates", 3, 0, 2, `[]`, false},
+		{"at the maximum number of updates", 3, 0, 3, `[]`, true},
+		{"small delta", 0, 100, 0, `[{"op":"add","path":"/a","value":1}]`, false},
+		{"large delta", 0, 10, 0, `[{"op":"add","path":"/a","value":1}]`, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := TModellingBusArtefactConnector{}
+			b.UseChainedUpdates(test.maxUpdates, test.maxDeltaSize)
+			b.chainedUpdatesPosted = test.chainedUpdatesPosted
+
+			if rebase := b.needsRebasing(TJSONDelta{Operations: []byte(test.operations)}); rebase != test.rebase {
+				t.Errorf("got %t, want %t", rebase, test.rebase)
+			}
+		})
+	}
+}
+
+func TestLateJoinerResynchronises(t *testing.T) {
+	state := `{"a":1}`
+	stateTimestamp := generics.GetTimestamp()
+
+	// The listener joins after the state was posted, so it did not receive it
+	connector := TModellingBusConnector{}
+	connector.Reporter = createTestArtefactConnector(t).ModellingBusConnector.Reporter
+	b := CreateModellingBusArtefactConnector(connector, "1.0", "artefact")
+
+	update := testDeltaJSON(t, state, `{"a":2}`, stateTimestamp, "")
+	if outcome := b.receiveJSONArtefactPosting(b.updateUpdatedJSONArtefact, update, generics.GetTimestamp()); outcome != jsonArtefactPostingOutOfSync {
+		t.Fatalf("got %q for an update against a state that was not received, want %q", outcome, jsonArtefactPostingOutOfSync)
+	}
+	if b.syncProblem == "" {
+		t.Errorf("the reason for getting out of sync should be given")
+	}
+	if len(b.UpdatedContent) != 0 {
+		t.Errorf("the update should not have been applied, got %s", b.UpdatedContent)
+	}
+
+	// Re-synchronising provides the state, after which the update can be applied
+	b.updateCurrentJSONArtefact([]byte(state), stateTimestamp)
+	if outcome := b.receiveJSONArtefactPosting(b.updateUpdatedJSONArtefact, update, generics.GetTimestamp()); outcome != jsonArtefactPostingApplied {
+		t.Fatalf("got %q for an update against the received state, want %q", outcome, jsonArtefactPostingApplied)
+	}
+	assertEqualJSON(t, b.UpdatedContent, []byte(`{"a":2}`))
+
+	// Once a newer state has been received, updates against the earlier state are superseded
+	newerStateTimestamp := generics.GetTimestamp()
+	b.updateCurrentJSONArtefact([]byte(`{"a":3}`), newerStateTimestamp)
+	if outcome := b.receiveJSONArtefactPosting(b.updateUpdatedJSONArtefact, update, generics.GetTimestamp()); outcome != jsonArtefactPostingApplied {
+		t.Errorf("got %q for a superseded update, want %q", outcome, jsonArtefactPostingApplied)
+	}
+	assertEqualJSON(t, b.UpdatedContent, []byte(`{"a":3}`))
+}
@@ -0,0 +1,219 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Undo
+ *
+ * This component provides undo and redo functionality for posters of artefacts on the BIG Modelling Bus.
+ * The poster keeps a local stack of the updates and considerings it posted. Undoing (or redoing) a change
+ * posts the corresponding inverse delta on the bus, so other agents see the effect immediately.
+ *
+ * The layering of states, updates and considerings is respected. A considering is a change relative to the
+ * updated content, so when a new update is posted, the considerings posted before can no longer be undone
+ * or redone. Likewise, posting a new state means none of the changes posted before can be undone or redone.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining artefact changes
+ */
+
+type (
+	tJSONArtefactChange struct {
+		layer  string          // The layer of the change (update or considering)
+		before json.RawMessage // The content of the layer before the change
+		after  json.RawMessage // The content of the layer after the change
+	}
+)
+
+/*
+ * Keeping track of changes
+ */
+
+// Removing the considering changes from a stack of changes
+func withoutConsideringChanges(changes []tJSONArtefactChange) []tJSONArtefactChange {
+	updateChanges := []tJSONArtefactChange{}
+	for _, change := range changes {
+		if change.layer != artefactConsideringPathElement {
+			updateChanges = append(updateChanges, change)
+		}
+	}
+
+	return updateChanges
+}
+
+// Recording a change that is about to be posted
+func (b *TModellingBusArtefactConnector) recordJSONArtefactChange(layer string, before, after json.RawMessage) {
+	// Before the state has been communicated, there is nothing to go back to
+	if !b.stateCommunicated {
+		return
+	}
+
+	// A new update invalidates the considerings posted before
+	if layer == artefactUpdatePathElement {
+		b.undoStack = withoutConsideringChanges(b.undoStack)
+	}
+
+	// Record the change, which also means the undone changes can no longer be redone
+	b.undoStack = append(b.undoStack, tJSONArtefactChange{layer, before, after})
+	b.redoStack = []tJSONArtefactChange{}
+}
+
+// Posting the given content for the layer of a change
+func (b *TModellingBusArtefactConnector) postJSONArtefactChange(layer string, content json.RawMessage) {
+	if layer == artefactUpdatePathElement {
+		b.postJSONArtefactUpdate(content)
+	} else {
+		b.postJSONArtefactConsidering(content)
+	}
+}
+
+// Computing the content resulting from applying the inverse of a change (from one content to another) to the present content
+func (b *TModellingBusArtefactConnector) invertedJSONArtefactContent(presentContent, from, to json.RawMessage) (json.RawMessage, bool) {
+	// The inverse of the change leads from the content after the change back to the content before it
+	inversePatch, err := generics.JSONDiff(to, from)
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong running the JSON diff:", err) {
+		return nil, false
+	}
+
+	// Apply the inverse to the present content
+	invertedContent, err := generics.JSONApplyPatch(presentContent, inversePatch)
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong applying the inverted JSON diff:", err) {
+		return nil, false
+	}
+
+	return invertedContent, true
+}
+
+// Getting the present content of a layer
+func (b *TModellingBusArtefactConnector) layerContent(layer string) json.RawMessage {
+	if layer == artefactUpdatePathElement {
+		return b.UpdatedContent
+	}
+
+	return b.ConsideredContent
+}
+
+// Moving the most recent change from the undo stack to the redo stack, returning its layer and the content with the change undone
+func (b *TModellingBusArtefactConnector) undoJSONArtefactChange() (string, json.RawMessage, bool) {
+	if len(b.undoStack) == 0 {
+		return "", nil, false
+	}
+
+	// Take the most recent change
+	change := b.undoStack[len(b.undoStack)-1]
+
+	// Determine the content with the change undone
+	undoneContent, ok := b.invertedJSONArtefactContent(b.layerContent(change.layer), change.before, change.after)
+	if !ok {
+		return "", nil, false
+	}
+
+	// Move the change to the redo stack
+	b.undoStack = b.undoStack[:len(b.undoStack)-1]
+	b.redoStack = append(b.redoStack, change)
+
+	return change.layer, undoneContent, true
+}
+
+// Moving the most recently undone change from the redo stack to the undo stack, returning its layer and the content with the change redone
+func (b *TModellingBusArtefactConnector) redoJSONArtefactChange() (string, json.RawMessage, bool) {
+	if len(b.redoStack) == 0 {
+		return "", nil, false
+	}
+
+	// Take the most recently undone change
+	change := b.redoStack[len(b.redoStack)-1]
+
+	// Determine the content with the change redone
+	redoneContent, ok := b.invertedJSONArtefactContent(b.layerContent(change.layer), change.after, change.before)
+	if !ok {
+		return "", nil, false
+	}
+
+	// Move the change to the undo stack, where a redone update invalidates the considerings posted before
+	b.redoStack = b.redoStack[:len(b.redoStack)-1]
+	if change.layer == artefactUpdatePathElement {
+		b.undoStack = withoutConsideringChanges(b.undoStack)
+		b.redoStack = withoutConsideringChanges(b.redoStack)
+	}
+	b.undoStack = append(b.undoStack, change)
+
+	return change.layer, redoneContent, true
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Checking whether there is a change that can be undone
+func (b *TModellingBusArtefactConnector) CanUndo() bool {
+	// In migrating mode, the changes are kept in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.busConnector.CanUndo()
+	}
+
+	return len(b.undoStack) > 0
+}
+
+// Checking whether there is a change that can be redone
+func (b *TModellingBusArtefactConnector) CanRedo() bool {
+	// In migrating mode, the changes are kept in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.busConnector.CanRedo()
+	}
+
+	return len(b.redoStack) > 0
+}
+
+// Undoing the most recent update or considering, by posting its inverse on the modelling bus
+func (b *TModellingBusArtefactConnector) Undo() bool {
+	// In migrating mode, undo in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().Undo() && b.presentMigratedJSONArtefact()
+	}
+
+	// Determine the content with the most recent change undone
+	layer, undoneContent, ok := b.undoJSONArtefactChange()
+	if !ok {
+		return false
+	}
+
+	// Post the inverse of the change
+	b.postJSONArtefactChange(layer, undoneContent)
+
+	return true
+}
+
+// Redoing the most recently undone update or considering, by posting it again on the modelling bus
+func (b *TModellingBusArtefactConnector) Redo() bool {
+	// In migrating mode, redo in the JSON version of the bus
+	if b.busConnector != nil {
+		return b.migratingConnector().Redo() && b.presentMigratedJSONArtefact()
+	}
+
+	// Determine the content with the most recently undone change redone
+	layer, redoneContent, ok := b.redoJSONArtefactChange()
+	if !ok {
+		return false
+	}
+
+	// Post the change again
+	b.postJSONArtefactChange(layer, redoneContent)
+
+	return true
+}
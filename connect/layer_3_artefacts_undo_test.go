@@ -0,0 +1,167 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Artefact Undo (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"testing"
+)
+
+// Creating an artefact connector of which the given state has been communicated
+func createTestUndoConnector(t *testing.T, state string) *TModellingBusArtefactConnector {
+	b := createTestArtefactConnector(t)
+	b.CurrentContent = []byte(state)
+	b.UpdatedContent = b.CurrentContent
+	b.ConsideredContent = b.CurrentContent
+	b.stateCommunicated = true
+
+	return b
+}
+
+// Setting the content of a layer, as posting it would
+func setTestLayerContent(b *TModellingBusArtefactConnector, layer string, content []byte) {
+	if layer == artefactUpdatePathElement {
+		b.UpdatedContent = content
+	}
+	b.ConsideredContent = content
+}
+
+// Posting the content of a layer, as far as the undo and redo stacks are concerned
+func postTestChange(b *TModellingBusArtefactConnector, layer, content string) {
+	b.recordJSONArtefactChange(layer, b.layerContent(layer), []byte(content))
+	setTestLayerContent(b, layer, []byte(content))
+}
+
+// Undoing the most recent change, as far as the contents of the layers are concerned
+func undoTestChange(b *TModellingBusArtefactConnector) bool {
+	layer, content, ok := b.undoJSONArtefactChange()
+	if ok {
+		setTestLayerContent(b, layer, content)
+	}
+
+	return ok
+}
+
+// Redoing the most recently undone change, as far as the contents of the layers are concerned
+func redoTestChange(b *TModellingBusArtefactConnector) bool {
+	layer, content, ok := b.redoJSONArtefactChange()
+	if ok {
+		setTestLayerContent(b, layer, content)
+	}
+
+	return ok
+}
+
+// Checking the contents of the update and considering layers
+func assertLayerContents(t *testing.T, b *TModellingBusArtefactConnector, updated, considered string) {
+	t.Helper()
+
+	assertEqualJSON(t, b.UpdatedContent, []byte(updated))
+	assertEqualJSON(t, b.ConsideredContent, []byte(considered))
+}
+
+func TestUndoRedo(t *testing.T) {
+	b := createTestUndoConnector(t, `{"a":0}`)
+	postTestChange(b, artefactUpdatePathElement, `{"a":1}`)
+	postTestChange(b, artefactUpdatePathElement, `{"a":2}`)
+
+	tests := []struct {
+		name    string
+		operate func(*TModellingBusArtefactConnector) bool
+		ok      bool
+		updated string
+	}{
+		{"undo the second update", undoTestChange, true, `{"a":1}`},
+		{"undo the first update", undoTestChange, true, `{"a":0}`},
+		{"nothing left to undo", undoTestChange, false, `{"a":0}`},
+		{"redo the first update", redoTestChange, true, `{"a":1}`},
+		{"redo the second update", redoTestChange, true, `{"a":2}`},
+		{"nothing left to redo", redoTestChange, false, `{"a":2}`},
+	}
+
+	// The steps build on each other, so they are run in the given order
+	for _, test := range tests {
+		if ok := test.operate(b); ok != test.ok {
+			t.Fatalf("%s: got %t, want %t", test.name, ok, test.ok)
+		}
+		assertLayerContents(t, b, test.updated, test.updated)
+	}
+}
+
+func TestUndoAppliesInverseToPresentContent(t *testing.T) {
+	b := createTestUndoConnector(t, `{"a":0}`)
+	postTestChange(b, artefactConsideringPathElement, `{"a":0,"b":1}`)
+
+	// The considered content changed since, e.g. by a change that is not recorded
+	b.ConsideredContent = []byte(`{"a":0,"b":1,"c":1}`)
+
+	if !undoTestChange(b) {
+		t.Fatalf("the considering should be undone")
+	}
+	assertLayerContents(t, b, `{"a":0}`, `{"a":0,"c":1}`)
+}
+
+func TestPostingInvalidatesRedo(t *testing.T) {
+	b := createTestUndoConnector(t, `{"a":0}`)
+	postTestChange(b, artefactUpdatePathElement, `{"a":1}`)
+	undoTestChange(b)
+
+	postTestChange(b, artefactUpdatePathElement, `{"a":2}`)
+	if b.CanRedo() {
+		t.Errorf("after posting a new change, the undone change should not be redoable")
+	}
+}
+
+func TestUpdateInvalidatesConsiderings(t *testing.T) {
+	b := createTestUndoConnector(t, `{"a":0}`)
+	postTestChange(b, artefactUpdatePathElement, `{"a":1}`)
+	postTestChange(b, artefactConsideringPathElement, `{"a":1,"b":1}`)
+	postTestChange(b, artefactUpdatePathElement, `{"a":2}`)
+
+	// Only the updates can be undone
+	undoTestChange(b)
+	assertLayerContents(t, b, `{"a":1}`, `{"a":1}`)
+	undoTestChange(b)
+	assertLayerContents(t, b, `{"a":0}`, `{"a":0}`)
+	if b.CanUndo() {
+		t.Errorf("the considering posted before the second update should not be undoable")
+	}
+}
+
+func TestRedoneUpdateInvalidatesConsiderings(t *testing.T) {
+	b := createTestUndoConnector(t, `{"a":0}`)
+	postTestChange(b, artefactUpdatePathElement, `{"a":1}`)
+	postTestChange(b, artefactConsideringPathElement, `{"a":1,"b":1}`)
+
+	// Undo the considering, and then the update
+	undoTestChange(b)
+	undoTestChange(b)
+	assertLayerContents(t, b, `{"a":0}`, `{"a":0}`)
+
+	// Redoing the update means the considering can no longer be redone
+	if !redoTestChange(b) {
+		t.Fatalf("the update should be redone")
+	}
+	assertLayerContents(t, b, `{"a":1}`, `{"a":1}`)
+	if b.CanRedo() {
+		t.Errorf("the considering posted before the redone update should not be redoable")
+	}
+}
+
+func TestChangesBeforeStateAreNotRecorded(t *testing.T) {
+	b := createTestArtefactConnector(t)
+	b.recordJSONArtefactChange(artefactUpdatePathElement, []byte(`{}`), []byte(`{"a":1}`))
+
+	if b.CanUndo() {
+		t.Errorf("a change posted before the state was communicated should not be undoable")
+	}
+}
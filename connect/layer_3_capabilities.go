@@ -0,0 +1,186 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Capabilities
+ *
+ * This module implements the capabilities related functionality of the modelling bus.
+ * Agents can advertise their capabilities (the JSON versions and raw formats they accept and produce, and
+ * the task types they support) as a retained posting under their agent topic.
+ * Coordinating agents can then look up which agents match a required capability.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining constants
+ */
+
+const (
+	capabilitiesPathElement = "capabilities"
+)
+
+/*
+ * Defining capabilities
+ */
+
+type (
+	// The capabilities as advertised by an agent
+	TAgentCapabilities struct {
+		InputJSONVersions  []string `json:"input json versions,omitempty"`  // The JSON versions of the artefacts accepted as input
+		InputRawFormats    []string `json:"input raw formats,omitempty"`    // The raw formats (e.g. "pdf", "png") accepted as input
+		OutputJSONVersions []string `json:"output json versions,omitempty"` // The JSON versions of the artefacts produced as output
+		OutputRawFormats   []string `json:"output raw formats,omitempty"`   // The raw formats produced as output
+		TaskTypes          []string `json:"task types,omitempty"`           // The task types supported by the agent
+	}
+
+	// A query for agents with a given capability.
+	// Fields that are left empty are not taken into account when matching.
+	TCapabilityQuery struct {
+		InputJSONVersion  string // The JSON version that should be accepted as input
+		InputRawFormat    string // The raw format that should be accepted as input
+		OutputJSONVersion string // The JSON version that should be produced as output
+		OutputRawFormat   string // The raw format that should be produced as output
+		TaskType          string // The task type that should be supported
+	}
+)
+
+/*
+ * Matching capabilities
+ */
+
+// Check whether a required value is offered, where an empty requirement is always met
+func capabilityOffered(offered []string, required string) bool {
+	return required == "" || slices.Contains(offered, required)
+}
+
+// Check whether the capabilities match the given query
+func (c TAgentCapabilities) Matches(query TCapabilityQuery) bool {
+	return capabilityOffered(c.InputJSONVersions, query.InputJSONVersion) &&
+		capabilityOffered(c.InputRawFormats, query.InputRawFormat) &&
+		capabilityOffered(c.OutputJSONVersions, query.OutputJSONVersion) &&
+		capabilityOffered(c.OutputRawFormats, query.OutputRawFormat) &&
+		capabilityOffered(c.TaskTypes, query.TaskType)
+}
+
+/*
+ * Converting capabilities
+ */
+
+// Converting a capabilities payload, as found on the modelling bus, to capabilities
+func (b *TModellingBusConnector) capabilitiesFromJSON(capabilitiesJSON []byte) (TAgentCapabilities, bool) {
+	capabilities := TAgentCapabilities{}
+
+	// If no payload is given, there are no capabilities
+	if len(capabilitiesJSON) == 0 {
+		return capabilities, false
+	}
+
+	// Unmarshal the capabilities
+	err := json.Unmarshal(capabilitiesJSON, &capabilities)
+
+	// Handle potential errors
+	if b.Reporter.MaybeReportError("Something went wrong unmarshalling the agent capabilities:", err) {
+		return TAgentCapabilities{}, false
+	}
+
+	return capabilities, true
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+/*
+ * Posting capabilities
+ */
+
+// Post the capabilities of this agent to the modelling bus
+func (b *TModellingBusConnector) PostCapabilities(capabilities TAgentCapabilities) {
+	// Convert the capabilities to JSON
+	capabilitiesJSON, err := json.Marshal(capabilities)
+
+	// Handle potential errors
+	if b.Reporter.MaybeReportError("Something went wrong JSONing the agent capabilities:", err) {
+		return
+	}
+
+	// Post the capabilities as a (retained) streamed event
+	b.postJSONAsStreamed(capabilitiesPathElement, capabilitiesJSON, generics.GetTimestamp())
+}
+
+/*
+ * Listening to capabilities related postings
+ */
+
+// Listen for capabilities postings of the given agent on the modelling bus
+func (b *TModellingBusConnector) ListenForCapabilitiesPostings(agentID string, postingHandler func(TAgentCapabilities, string)) {
+	b.listenForStreamedPostings(agentID, capabilitiesPathElement, func(capabilitiesJSON []byte, timestamp string) {
+		if capabilities, ok := b.capabilitiesFromJSON(capabilitiesJSON); ok {
+			postingHandler(capabilities, timestamp)
+		}
+	})
+}
+
+/*
+ * Retrieving capabilities
+ */
+
+// Retrieve the capabilities of the given agent from the modelling bus
+func (b *TModellingBusConnector) GetCapabilities(agentID string) (TAgentCapabilities, bool) {
+	capabilitiesJSON, _ := b.getStreamedEvent(agentID, capabilitiesPathElement)
+
+	return b.capabilitiesFromJSON(capabilitiesJSON)
+}
+
+// Retrieve the capabilities of all agents that have advertised capabilities on the modelling bus
+func (b *TModellingBusConnector) GetAllCapabilities() map[string]TAgentCapabilities {
+	allCapabilities := map[string]TAgentCapabilities{}
+
+	// Collect the capabilities of each advertising agent
+	for _, agentID := range b.modellingBusEventsConnector.agentsWithEventsOn(capabilitiesPathElement) {
+		if capabilities, ok := b.GetCapabilities(agentID); ok {
+			allCapabilities[agentID] = capabilities
+		}
+	}
+
+	return allCapabilities
+}
+
+// Find the IDs of the agents with capabilities matching the given query
+// Note: this requires the modelling bus connector not to be created in posting only mode.
+func (b *TModellingBusConnector) FindAgents(query TCapabilityQuery) []string {
+	agentIDs := []string{}
+
+	// Check the capabilities of each advertising agent
+	for _, agentID := range b.modellingBusEventsConnector.agentsWithEventsOn(capabilitiesPathElement) {
+		if capabilities, ok := b.GetCapabilities(agentID); ok && capabilities.Matches(query) {
+			agentIDs = append(agentIDs, agentID)
+		}
+	}
+
+	return agentIDs
+}
+
+/*
+ * Deleting capabilities
+ */
+
+// Delete the capabilities of this agent from the modelling bus
+func (b *TModellingBusConnector) DeleteCapabilities() {
+	b.deletePosting(capabilitiesPathElement)
+}
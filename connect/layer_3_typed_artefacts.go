@@ -0,0 +1,364 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Typed Artefacts
+ *
+ * This component provides typed posters and listeners for JSON artefacts on the BIG Modelling Bus.
+ * They are parameterised by the Go type of the models, and the JSON version used for them on the bus.
+ * The models are (un)marshalled using the standard JSON encoding, so adding a modelling language mainly
+ * requires the definition of the model type.
+ *
+ * When the model type (as a pointer) has a Clean() method, it is called before unmarshalling a model, e.g.
+ * to initialise the maps of the model.
+ * When it has a Validate() method, posters can be asked to validate models before posting them, and listeners
+ * to report the findings of validating the models they receive.
+ * When it has an AdoptModel() method, it is used to set a model to a model obtained from the modelling bus,
+ * e.g. to keep the properties of the model that are not posted on the bus.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"encoding/json"
+)
+
+/*
+ * Defining typed posters and listeners
+ */
+
+type (
+	// A poster of models of type T
+	TPoster[T any] struct {
+		ArtefactConnector TModellingBusArtefactConnector // The artefact connector used to post the models
+
+		validateBeforePosting bool // Whether models are validated before posting them
+	}
+
+	// A listener for models of type T
+	TListener[T any] struct {
+		ArtefactConnector TModellingBusArtefactConnector // The artefact connector used to listen for the models
+
+		findingsHandler func(string, []TFinding) // The handler for the findings of validating received models
+	}
+
+	// A finding of the validation of a model
+	TFinding struct {
+		Kind      string `json:"kind"`       // The kind of finding
+		ElementID string `json:"element id"` // The element the finding is about
+		Message   string `json:"message"`    // A description of the finding
+	}
+
+	// Models that need to be cleaned before unmarshalling
+	tCleanableModel interface {
+		Clean()
+	}
+
+	// Models that can be validated
+	tValidatableModel interface {
+		Validate() []TFinding
+	}
+
+	// Models that adopt models obtained from the modelling bus themselves
+	tAdoptingModel[T any] interface {
+		AdoptModel(T)
+	}
+)
+
+/*
+ * Converting models
+ */
+
+// Converting a model to JSON
+func modelAsJSON[T any](b *TModellingBusArtefactConnector, model T) ([]byte, bool) {
+	modelJSON, err := json.Marshal(model)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong when converting model to JSON.", err) {
+		return []byte{}, false
+	}
+
+	return modelJSON, true
+}
+
+// Converting JSON to a model, where empty JSON results in an empty model
+func modelFromJSON[T any](b *TModellingBusArtefactConnector, modelJSON []byte) (T, bool) {
+	var model T
+
+	// Clean the model, if needed
+	if cleanableModel, isCleanable := any(&model).(tCleanableModel); isCleanable {
+		cleanableModel.Clean()
+	}
+
+	// An empty JSON results in an empty model
+	if len(modelJSON) == 0 {
+		return model, true
+	}
+
+	err := json.Unmarshal(modelJSON, &model)
+
+	// Handle potential errors
+	if b.ModellingBusConnector.Reporter.MaybeReportError("Something went wrong when converting JSON to model.", err) {
+		return model, false
+	}
+
+	return model, true
+}
+
+// Setting a model to a model obtained from the modelling bus
+func adoptModel[T any](target *T, model T) {
+	// Let the model adopt the model, if it does so itself
+	if adoptingModel, isAdopting := any(target).(tAdoptingModel[T]); isAdopting {
+		adoptingModel.AdoptModel(model)
+		return
+	}
+
+	*target = model
+}
+
+/*
+ * Validating models
+ */
+
+// Getting the findings of validating a model, where models that cannot be validated have no findings
+func modelFindings[T any](model T) []TFinding {
+	if validatableModel, isValidatable := any(&model).(tValidatableModel); isValidatable {
+		return validatableModel.Validate()
+	}
+
+	return []TFinding{}
+}
+
+// Checking whether the model can be posted, reporting the findings of its validation if not
+func (p *TPoster[T]) isPostable(model T) bool {
+	if !p.validateBeforePosting {
+		return true
+	}
+
+	// Validate the model
+	findings := modelFindings(model)
+	for _, finding := range findings {
+		p.ArtefactConnector.ModellingBusConnector.Reporter.Error("Not posting artefact %s, as %s.", p.ArtefactConnector.ArtefactID, finding.Message)
+	}
+
+	return len(findings) == 0
+}
+
+// Reporting the findings of validating the received models, if requested
+func (l *TListener[T]) reportFindings() {
+	if l.findingsHandler == nil {
+		return
+	}
+
+	// Validate the model of each layer
+	for _, layer := range []struct {
+		name    string
+		content []byte
+	}{
+		{"state", l.ArtefactConnector.CurrentContent},
+		{"update", l.ArtefactConnector.UpdatedContent},
+		{"considering", l.ArtefactConnector.ConsideredContent},
+	} {
+		if model, ok := modelFromJSON[T](&l.ArtefactConnector, layer.content); ok {
+			if findings := modelFindings(model); len(findings) > 0 {
+				l.findingsHandler(layer.name, findings)
+			}
+		}
+	}
+}
+
+// Setting a model to the considered model, as posted
+func (p *TPoster[T]) adoptConsideredModel(target *T) bool {
+	model, ok := modelFromJSON[T](&p.ArtefactConnector, p.ArtefactConnector.ConsideredContent)
+	if ok {
+		adoptModel(target, model)
+	}
+
+	return ok
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+/*
+ * Posting models
+ */
+
+// Posting the model's state
+func (p *TPoster[T]) PostState(model T) {
+	if p.isPostable(model) {
+		p.ArtefactConnector.PostJSONArtefactState(modelAsJSON(&p.ArtefactConnector, model))
+	}
+}
+
+// Posting the model's update
+func (p *TPoster[T]) PostUpdate(model T) {
+	if p.isPostable(model) {
+		p.ArtefactConnector.PostJSONArtefactUpdate(modelAsJSON(&p.ArtefactConnector, model))
+	}
+}
+
+// Posting the model's considered update
+func (p *TPoster[T]) PostConsidering(model T) {
+	if p.isPostable(model) {
+		p.ArtefactConnector.PostJSONArtefactConsidering(modelAsJSON(&p.ArtefactConnector, model))
+	}
+}
+
+/*
+ * Undoing and redoing postings
+ */
+
+// Undoing the most recent update or considering posted, setting the given model to the resulting considered model
+func (p *TPoster[T]) Undo(model *T) bool {
+	return p.ArtefactConnector.Undo() && p.adoptConsideredModel(model)
+}
+
+// Redoing the most recently undone update or considering, setting the given model to the resulting considered model
+func (p *TPoster[T]) Redo(model *T) bool {
+	return p.ArtefactConnector.Redo() && p.adoptConsideredModel(model)
+}
+
+/*
+ * Resuming the posting of models
+ */
+
+// Resuming the posting of the model after a restart of the posting agent.
+// The state, update and considering previously posted are adopted from the modelling bus, and the given
+// model is set to the considered model, i.e. the latest version posted.
+func (p *TPoster[T]) Resume(model *T) bool {
+	return p.ArtefactConnector.ResumeJSONArtefactPosting() && p.adoptConsideredModel(model)
+}
+
+/*
+ * Getting the posted models
+ */
+
+// Getting the current model, as posted
+func (p *TPoster[T]) Current() T {
+	model, _ := modelFromJSON[T](&p.ArtefactConnector, p.ArtefactConnector.CurrentContent)
+
+	return model
+}
+
+// Getting the updated model, as posted
+func (p *TPoster[T]) Updated() T {
+	model, _ := modelFromJSON[T](&p.ArtefactConnector, p.ArtefactConnector.UpdatedContent)
+
+	return model
+}
+
+// Getting the considered model, as posted
+func (p *TPoster[T]) Considered() T {
+	model, _ := modelFromJSON[T](&p.ArtefactConnector, p.ArtefactConnector.ConsideredContent)
+
+	return model
+}
+
+/*
+ * Configuring the model poster
+ */
+
+// Posting updates as chained deltas, re-basing the state after maxUpdates updates, or when a delta exceeds maxDeltaSize bytes
+func (p *TPoster[T]) UseChainedUpdates(maxUpdates, maxDeltaSize int) {
+	p.ArtefactConnector.UseChainedUpdates(maxUpdates, maxDeltaSize)
+}
+
+// Posting deltas in the given format, e.g. JSONDeltaFormatMergePatch for more readable deltas
+func (p *TPoster[T]) UseDeltaFormat(deltaFormat string) bool {
+	return p.ArtefactConnector.UseDeltaFormat(deltaFormat)
+}
+
+// Validating models before posting them, where models with findings are not posted
+func (p *TPoster[T]) UseValidation() {
+	p.validateBeforePosting = true
+}
+
+/*
+ * Listening for models
+ */
+
+// Listening for model state postings, where the handler receives the current model
+func (l *TListener[T]) ListenForStatePostings(agentID, artefactID string, handler func(T)) {
+	l.ArtefactConnector.ListenForJSONArtefactStatePostings(agentID, artefactID, func() {
+		if model, ok := modelFromJSON[T](&l.ArtefactConnector, l.ArtefactConnector.CurrentContent); ok {
+			l.reportFindings()
+			handler(model)
+		}
+	})
+}
+
+// Listening for model update postings, where the handler receives the updated model
+func (l *TListener[T]) ListenForUpdatePostings(agentID, artefactID string, handler func(T)) {
+	l.ArtefactConnector.ListenForJSONArtefactUpdatePostings(agentID, artefactID, func() {
+		if model, ok := modelFromJSON[T](&l.ArtefactConnector, l.ArtefactConnector.UpdatedContent); ok {
+			l.reportFindings()
+			handler(model)
+		}
+	})
+}
+
+// Listening for model considering postings, where the handler receives the considered model
+func (l *TListener[T]) ListenForConsideringPostings(agentID, artefactID string, handler func(T)) {
+	l.ArtefactConnector.ListenForJSONArtefactConsideringPostings(agentID, artefactID, func() {
+		if model, ok := modelFromJSON[T](&l.ArtefactConnector, l.ArtefactConnector.ConsideredContent); ok {
+			l.reportFindings()
+			handler(model)
+		}
+	})
+}
+
+// Validating the received models, where the handler receives the layer (state, update or considering) and the
+// findings for each model with findings
+func (l *TListener[T]) SetFindingsHandler(handler func(string, []TFinding)) {
+	l.findingsHandler = handler
+}
+
+// Getting the current model, as received
+func (l *TListener[T]) Current() T {
+	model, _ := modelFromJSON[T](&l.ArtefactConnector, l.ArtefactConnector.CurrentContent)
+
+	return model
+}
+
+// Getting the updated model, as received
+func (l *TListener[T]) Updated() T {
+	model, _ := modelFromJSON[T](&l.ArtefactConnector, l.ArtefactConnector.UpdatedContent)
+
+	return model
+}
+
+// Getting the considered model, as received
+func (l *TListener[T]) Considered() T {
+	model, _ := modelFromJSON[T](&l.ArtefactConnector, l.ArtefactConnector.ConsideredContent)
+
+	return model
+}
+
+/*
+ * Creating typed posters and listeners
+ */
+
+// Creating a poster for models of type T, posted in the given JSON version as the given artefact
+func CreatePoster[T any](ModellingBusConnector TModellingBusConnector, JSONVersion, ArtefactID string) TPoster[T] {
+	poster := TPoster[T]{}
+	poster.ArtefactConnector = CreateModellingBusArtefactConnector(ModellingBusConnector, JSONVersion, ArtefactID)
+
+	return poster
+}
+
+// Creating a listener for models of type T, posted in the given JSON version
+func CreateListener[T any](ModellingBusConnector TModellingBusConnector, JSONVersion string) TListener[T] {
+	listener := TListener[T]{}
+	listener.ArtefactConnector = CreateModellingBusArtefactConnector(ModellingBusConnector, JSONVersion, "")
+
+	return listener
+}
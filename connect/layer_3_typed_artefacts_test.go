@@ -0,0 +1,116 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Connect
+ * Component: Layer 3 - Typed Artefacts (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package connect
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+// A model that can be validated, and that keeps a property not posted on the modelling bus when adopting models
+type tTestModel struct {
+	Name  string `json:"name"` // The name of the model, which is required
+	local string // A property not posted on the modelling bus
+}
+
+// Validating the model
+func (m *tTestModel) Validate() []TFinding {
+	if m.Name == "" {
+		return []TFinding{{Kind: "missing name", Message: "the model has no name"}}
+	}
+
+	return []TFinding{}
+}
+
+// Adopting a model obtained from the modelling bus
+func (m *tTestModel) AdoptModel(model tTestModel) {
+	model.local = m.local
+
+	*m = model
+}
+
+func TestIsPostable(t *testing.T) {
+	tests := []struct {
+		name       string
+		validation bool
+		model      tTestModel
+		postable   bool
+	}{
+		{"without validation", false, tTestModel{}, true},
+		{"valid model", true, tTestModel{Name: "model"}, true},
+		{"invalid model", true, tTestModel{}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Count the reported errors, where each finding is reported
+			reportedErrors := 0
+			p := TPoster[tTestModel]{}
+			p.ArtefactConnector.ModellingBusConnector.Reporter = generics.CreateReporter(generics.ProgressLevelBasic, func(string) { reportedErrors++ }, func(string) {})
+			if test.validation {
+				p.UseValidation()
+			}
+
+			if postable := p.isPostable(test.model); postable != test.postable {
+				t.Errorf("got %t, want %t", postable, test.postable)
+			}
+			if postable := reportedErrors == 0; postable != test.postable {
+				t.Errorf("got %d reported errors for a model that is postable: %t", reportedErrors, test.postable)
+			}
+		})
+	}
+
+	// Models that cannot be validated are always postable
+	p := TPoster[map[string]string]{}
+	p.UseValidation()
+	if !p.isPostable(map[string]string{}) {
+		t.Errorf("a model that cannot be validated should be postable")
+	}
+}
+
+func TestAdoptModel(t *testing.T) {
+	model := tTestModel{Name: "old", local: "kept"}
+	adoptModel(&model, tTestModel{Name: "new"})
+	if model.Name != "new" || model.local != "kept" {
+		t.Errorf("got %+v, want the new name with the local property kept", model)
+	}
+
+	// Models that do not adopt models themselves are replaced
+	plainModel := map[string]string{"name": "old"}
+	adoptModel(&plainModel, map[string]string{"name": "new"})
+	if plainModel["name"] != "new" {
+		t.Errorf("got %v, want the new model", plainModel)
+	}
+}
+
+func TestReportFindings(t *testing.T) {
+	l := TListener[tTestModel]{}
+	l.ArtefactConnector = *createTestArtefactConnector(t)
+	l.ArtefactConnector.CurrentContent = []byte(`{"name":"model"}`)
+	l.ArtefactConnector.UpdatedContent = []byte(`{"name":""}`)
+	l.ArtefactConnector.ConsideredContent = []byte(`{}`)
+
+	// Without a findings handler, the models are not validated
+	l.reportFindings()
+
+	layers := []string{}
+	l.SetFindingsHandler(func(layer string, findings []TFinding) {
+		layers = append(layers, layer)
+	})
+	l.reportFindings()
+	if want := []string{"update", "considering"}; !slices.Equal(layers, want) {
+		t.Errorf("got findings for %v, want %v", layers, want)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: Checksums
+ *
+ * This component provides the functionality to compute checksums of data, files and JSONs.
+ * These checksums are used to verify the integrity of the artefacts exchanged via the BIG Modelling Bus.
+ * For JSONs, the checksum is computed over a canonicalised version of the JSON, so that differences in
+ * layout (white space, order of keys) do not influence the checksum.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Digest computes the (SHA-256) digest of the given data.
+func Digest(data []byte) string {
+	digest := sha256.Sum256(data)
+
+	return hex.EncodeToString(digest[:])
+}
+
+// FileDigest computes the size and the (SHA-256) digest of the given file.
+func FileDigest(filePath string) (int64, string, error) {
+	// Open the file for reading
+	file, err := os.Open(filepath.FromSlash(filePath))
+	if err != nil {
+		return 0, "", err
+	}
+
+	// Ensure the file is closed afterwards
+	defer file.Close()
+
+	// Compute the digest while reading the file
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// CanonicalJSON converts a JSON to its canonical form, i.e. without white space and with ordered keys.
+func CanonicalJSON(message []byte) (json.RawMessage, error) {
+	var value any
+	if err := json.Unmarshal(message, &value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(value)
+}
+
+// JSONHash computes the (SHA-256) digest of the canonical form of the given JSON.
+func JSONHash(message []byte) (string, error) {
+	canonicalJSON, err := CanonicalJSON(message)
+	if err != nil {
+		return "", err
+	}
+
+	return Digest(canonicalJSON), nil
+}
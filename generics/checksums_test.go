@@ -0,0 +1,89 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: Checksums (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDigest(t *testing.T) {
+	tests := []struct {
+		data   string
+		digest string
+	}{
+		{"", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{"abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+
+	for _, test := range tests {
+		if digest := Digest([]byte(test.data)); digest != test.digest {
+			t.Errorf("Digest(%q) = %s, want %s", test.data, digest, test.digest)
+		}
+	}
+}
+
+func TestFileDigest(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "payload.json")
+	if err := os.WriteFile(filePath, []byte("abc"), 0o644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	size, digest, err := FileDigest(filePath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if size != 3 || digest != Digest([]byte("abc")) {
+		t.Errorf("got %d, %s, want 3, %s", size, digest, Digest([]byte("abc")))
+	}
+
+	if _, _, err := FileDigest(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+}
+
+func TestJSONHash(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  string
+		right string
+		equal bool
+	}{
+		{"layout", `{"a":1,"b":[1,2]}`, "{\n  \"a\": 1,\n  \"b\": [1, 2]\n}", true},
+		{"order of keys", `{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{"different values", `{"a":1}`, `{"a":2}`, false},
+		{"order of array elements", `[1,2]`, `[2,1]`, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			leftHash, err := JSONHash([]byte(test.left))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rightHash, err := JSONHash([]byte(test.right))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (leftHash == rightHash) != test.equal {
+				t.Errorf("hashes equal = %t, want %t", leftHash == rightHash, test.equal)
+			}
+		})
+	}
+
+	if _, err := JSONHash([]byte(`{`)); err == nil {
+		t.Errorf("expected an error for invalid JSON")
+	}
+}
@@ -20,7 +20,11 @@ package generics
 
 const (
 	ModellingBusVersion = "bus-version-1.0"         // The current version of the BIG modelling bus.
-	PayloadFileName     = "payload"                 // Name of the file used to store the "payload" of artefacts on the FTP server.
-	JSONExtension       = ".json"                   // Name of the local file used to (temporarily) represent upload/downloaded JSONs.
+	JSONExtension       = ".json"                   // Extension of the files used to represent JSONs, both locally and on the FTP server.
 	JSONFileName        = "message" + JSONExtension // Name of the local file used to (temporarily) represent upload/downloaded JSONs.
+
+	// Name of the file used to store the "payload" of artefacts on the FTP server.
+	//
+	// Deprecated: files on the FTP server are now named after the timestamp of their posting, to keep their history.
+	PayloadFileName = "payload"
 )
@@ -0,0 +1,262 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: JSON Merging
+ *
+ * This component provides the functionality to merge JSONs, as well as to invert, compose and rebase patches.
+ * The patches are compliant to the https://datatracker.ietf.org/doc/html/rfc6902 standard, and are computed using
+ * the JSONDiff and JSONApplyPatch functions from the JSON Operations component.
+ *
+ * The three-way merge works on the structure of the JSONs. Objects are merged member by member, while all other
+ * values (including arrays) are treated as atomic values. When both sides changed the same value in different
+ * ways, this is reported as a conflict, and the value of "ours" is retained.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+/*
+ * Defining merge conflicts
+ */
+
+type (
+	// A conflict found while merging JSONs
+	TJSONConflict struct {
+		Path   string          `json:"path"`             // JSON pointer (https://datatracker.ietf.org/doc/html/rfc6901) to the conflicting value
+		Base   json.RawMessage `json:"base,omitempty"`   // The value in the base JSON (if present)
+		Ours   json.RawMessage `json:"ours,omitempty"`   // The value in our JSON (if present)
+		Theirs json.RawMessage `json:"theirs,omitempty"` // The value in their JSON (if present)
+	}
+
+	// A (possibly absent) value within a JSON
+	tJSONValue struct {
+		present bool // Whether the value is present
+		value   any  // The value itself
+	}
+)
+
+/*
+ * Decoding and encoding JSON values
+ */
+
+// Decode a JSON into a generic value, preserving the representation of numbers.
+// An empty JSON, e.g. the content of an artefact without a state, decodes to an absent value.
+func decodeJSONValue(message []byte) (tJSONValue, error) {
+	if len(bytes.TrimSpace(message)) == 0 {
+		return tJSONValue{}, nil
+	}
+
+	var value any
+
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	err := decoder.Decode(&value)
+
+	return tJSONValue{true, value}, err
+}
+
+// Encode a (possibly absent) value for the reporting of conflicts
+func encodeJSONValue(value tJSONValue) json.RawMessage {
+	if !value.present {
+		return nil
+	}
+
+	encodedValue, _ := json.Marshal(value.value)
+
+	return encodedValue
+}
+
+// Extend a JSON pointer with a member name, escaping it as required by RFC 6901
+func extendJSONPointer(pointer, member string) string {
+	return pointer + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(member)
+}
+
+/*
+ * Merging JSON values
+ */
+
+// Check whether two (possibly absent) values are equal
+func (v tJSONValue) equals(w tJSONValue) bool {
+	return v.present == w.present && reflect.DeepEqual(v.value, w.value)
+}
+
+// Get the members of a value if it is a present object
+func (v tJSONValue) members() (map[string]any, bool) {
+	if !v.present {
+		return nil, false
+	}
+
+	members, isObject := v.value.(map[string]any)
+
+	return members, isObject
+}
+
+// Get a member of an object
+func memberValue(members map[string]any, member string) tJSONValue {
+	value, present := members[member]
+
+	return tJSONValue{present, value}
+}
+
+// Merge three versions of a value, collecting conflicts
+func mergeJSONValues(pointer string, base, ours, theirs tJSONValue, conflicts *[]TJSONConflict) tJSONValue {
+	// When both sides agree, or only one side changed the value, there is no conflict
+	switch {
+	case ours.equals(theirs):
+		return ours
+	case base.equals(ours):
+		return theirs
+	case base.equals(theirs):
+		return ours
+	}
+
+	// When both sides are objects, merge them member by member
+	ourMembers, oursIsObject := ours.members()
+	theirMembers, theirsIsObject := theirs.members()
+	if oursIsObject && theirsIsObject {
+		baseMembers, _ := base.members()
+
+		// Collect all members, in a predictable order
+		memberSet := map[string]bool{}
+		for _, members := range []map[string]any{baseMembers, ourMembers, theirMembers} {
+			for member := range members {
+				memberSet[member] = true
+			}
+		}
+		memberNames := []string{}
+		for member := range memberSet {
+			memberNames = append(memberNames, member)
+		}
+		sort.Strings(memberNames)
+
+		// Merge the members
+		mergedMembers := map[string]any{}
+		for _, member := range memberNames {
+			mergedValue := mergeJSONValues(extendJSONPointer(pointer, member),
+				memberValue(baseMembers, member), memberValue(ourMembers, member), memberValue(theirMembers, member), conflicts)
+			if mergedValue.present {
+				mergedMembers[member] = mergedValue.value
+			}
+		}
+
+		return tJSONValue{true, mergedMembers}
+	}
+
+	// Otherwise, both sides changed the same value in a different way
+	conflict := TJSONConflict{}
+	conflict.Path = pointer
+	conflict.Base = encodeJSONValue(base)
+	conflict.Ours = encodeJSONValue(ours)
+	conflict.Theirs = encodeJSONValue(theirs)
+	*conflicts = append(*conflicts, conflict)
+
+	// Retain our value
+	return ours
+}
+
+/*
+ * Externally visible functionality
+ */
+
+// JSONMerge3 merges two JSONs (ours and theirs) that have both been derived from a common base JSON.
+// It returns the merged JSON, as well as the conflicts that were found, which have been resolved in favour of ours.
+func JSONMerge3(baseJSON, ourJSON, theirJSON []byte) (json.RawMessage, []TJSONConflict, error) {
+	conflicts := []TJSONConflict{}
+
+	// Decode the JSONs
+	base, err := decodeJSONValue(baseJSON)
+	if err != nil {
+		return nil, conflicts, err
+	}
+	ours, err := decodeJSONValue(ourJSON)
+	if err != nil {
+		return nil, conflicts, err
+	}
+	theirs, err := decodeJSONValue(theirJSON)
+	if err != nil {
+		return nil, conflicts, err
+	}
+
+	// Merge them
+	merged := mergeJSONValues("", base, ours, theirs, &conflicts)
+
+	// When the merged result is absent, so is its JSON
+	if !merged.present {
+		return json.RawMessage{}, conflicts, nil
+	}
+
+	// Encode the merged result
+	mergedJSON, err := json.Marshal(merged.value)
+
+	return mergedJSON, conflicts, err
+}
+
+// JSONInvertPatch computes the patch that undoes the effect of applying a patch to a source JSON.
+func JSONInvertPatch(sourceJSON, patchJSON []byte) (json.RawMessage, error) {
+	// Determine the result of the patch
+	targetJSON, err := JSONApplyPatch(sourceJSON, patchJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// The inverse patch leads from the result back to the source
+	return JSONDiff(targetJSON, sourceJSON)
+}
+
+// JSONComposePatches squashes two consecutive patches, the first applying to the source JSON and the second
+// to the result of the first, into a single patch applying to the source JSON.
+func JSONComposePatches(sourceJSON, firstPatchJSON, secondPatchJSON []byte) (json.RawMessage, error) {
+	// Apply the first patch
+	intermediateJSON, err := JSONApplyPatch(sourceJSON, firstPatchJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the second patch
+	targetJSON, err := JSONApplyPatch(intermediateJSON, secondPatchJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// The composed patch leads from the source directly to the final result
+	return JSONDiff(sourceJSON, targetJSON)
+}
+
+// JSONRebasePatch rebases a patch against a base JSON onto another patch against the same base JSON.
+// The rebased patch applies to the result of the other patch. Conflicts between the two patches are
+// returned, and have been resolved in favour of the other patch.
+func JSONRebasePatch(baseJSON, patchJSON, ontoPatchJSON []byte) (json.RawMessage, []TJSONConflict, error) {
+	// Determine the results of both patches
+	ontoJSON, err := JSONApplyPatch(baseJSON, ontoPatchJSON)
+	if err != nil {
+		return nil, []TJSONConflict{}, err
+	}
+	patchedJSON, err := JSONApplyPatch(baseJSON, patchJSON)
+	if err != nil {
+		return nil, []TJSONConflict{}, err
+	}
+
+	// Merge both results
+	mergedJSON, conflicts, err := JSONMerge3(baseJSON, ontoJSON, patchedJSON)
+	if err != nil {
+		return nil, conflicts, err
+	}
+
+	// The rebased patch leads from the result of the other patch to the merged result
+	rebasedPatchJSON, err := JSONDiff(ontoJSON, mergedJSON)
+
+	return rebasedPatchJSON, conflicts, err
+}
@@ -0,0 +1,193 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: JSON Merging (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"testing"
+)
+
+// Check that two JSONs are equal, irrespective of their layout
+func assertEqualJSON(t *testing.T, got, want []byte) {
+	t.Helper()
+
+	canonicalGot, err := CanonicalJSON(got)
+	if err != nil {
+		t.Fatalf("invalid JSON %s: %v", got, err)
+	}
+	canonicalWant, err := CanonicalJSON(want)
+	if err != nil {
+		t.Fatalf("invalid expected JSON %s: %v", want, err)
+	}
+
+	if string(canonicalGot) != string(canonicalWant) {
+		t.Errorf("got %s, want %s", canonicalGot, canonicalWant)
+	}
+}
+
+// Get the paths of the conflicts
+func conflictPaths(conflicts []TJSONConflict) []string {
+	paths := []string{}
+	for _, conflict := range conflicts {
+		paths = append(paths, conflict.Path)
+	}
+
+	return paths
+}
+
+func TestJSONMerge3(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		merged    string
+		conflicts []string
+	}{
+		{"unchanged", `{"a":1}`, `{"a":1}`, `{"a":1}`, `{"a":1}`, []string{}},
+		{"only ours changed", `{"a":1}`, `{"a":2}`, `{"a":1}`, `{"a":2}`, []string{}},
+		{"only theirs changed", `{"a":1}`, `{"a":1}`, `{"a":2}`, `{"a":2}`, []string{}},
+		{"both changed alike", `{"a":1}`, `{"a":2}`, `{"a":2}`, `{"a":2}`, []string{}},
+		{"different members changed", `{"a":1,"b":1}`, `{"a":2,"b":1}`, `{"a":1,"b":2}`, `{"a":2,"b":2}`, []string{}},
+		{"members added and removed", `{"a":1,"b":1}`, `{"b":1,"c":1}`, `{"a":1,"b":1,"d":1}`, `{"b":1,"c":1,"d":1}`, []string{}},
+		{"nested objects", `{"o":{"a":1,"b":1}}`, `{"o":{"a":2,"b":1}}`, `{"o":{"a":1,"b":2}}`, `{"o":{"a":2,"b":2}}`, []string{}},
+		{"conflicting values", `{"a":1}`, `{"a":2}`, `{"a":3}`, `{"a":2}`, []string{"/a"}},
+		{"conflicting removal", `{"a":1}`, `{}`, `{"a":3}`, `{}`, []string{"/a"}},
+		{"arrays are atomic", `{"l":[1]}`, `{"l":[1,2]}`, `{"l":[1,3]}`, `{"l":[1,2]}`, []string{"/l"}},
+		{"escaped member names", `{"a/b":1}`, `{"a/b":2}`, `{"a/b":3}`, `{"a/b":2}`, []string{"/a~1b"}},
+		{"empty base", ``, `{"a":1}`, `{"b":1}`, `{"a":1,"b":1}`, []string{}},
+		{"empty base with conflict", ``, `{"a":1}`, `{"a":2}`, `{"a":1}`, []string{"/a"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, conflicts, err := JSONMerge3([]byte(test.base), []byte(test.ours), []byte(test.theirs))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertEqualJSON(t, merged, []byte(test.merged))
+
+			paths := conflictPaths(conflicts)
+			if len(paths) != len(test.conflicts) {
+				t.Fatalf("got conflicts %v, want %v", paths, test.conflicts)
+			}
+			for i := range paths {
+				if paths[i] != test.conflicts[i] {
+					t.Errorf("got conflicts %v, want %v", paths, test.conflicts)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONMerge3Empty(t *testing.T) {
+	merged, conflicts, err := JSONMerge3([]byte{}, []byte{}, []byte{})
+	if err != nil || len(merged) != 0 || len(conflicts) != 0 {
+		t.Errorf("got %s, %v, %v, want an empty merge", merged, conflicts, err)
+	}
+}
+
+func TestJSONMerge3Invalid(t *testing.T) {
+	if _, _, err := JSONMerge3([]byte(`{}`), []byte(`{`), []byte(`{}`)); err == nil {
+		t.Errorf("expected an error for invalid JSON")
+	}
+}
+
+func TestJSONInvertPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		target string
+	}{
+		{"replace", `{"a":1}`, `{"a":2}`},
+		{"add", `{"a":1}`, `{"a":1,"b":2}`},
+		{"remove", `{"a":1,"b":2}`, `{"a":1}`},
+		{"nested", `{"o":{"l":[1,2]}}`, `{"o":{"l":[2]},"p":null}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patch, err := JSONDiff([]byte(test.source), []byte(test.target))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			inverse, err := JSONInvertPatch([]byte(test.source), patch)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			restored, err := JSONApplyPatch([]byte(test.target), inverse)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertEqualJSON(t, restored, []byte(test.source))
+		})
+	}
+}
+
+func TestJSONComposePatches(t *testing.T) {
+	source := []byte(`{"a":1,"b":1}`)
+	intermediate := []byte(`{"a":2,"b":1}`)
+	target := []byte(`{"a":2,"c":1}`)
+
+	firstPatch, _ := JSONDiff(source, intermediate)
+	secondPatch, _ := JSONDiff(intermediate, target)
+
+	composedPatch, err := JSONComposePatches(source, firstPatch, secondPatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := JSONApplyPatch(source, composedPatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assertEqualJSON(t, result, target)
+}
+
+func TestJSONRebasePatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		patched   string
+		onto      string
+		result    string
+		conflicts int
+	}{
+		{"independent changes", `{"a":1,"b":1}`, `{"a":2,"b":1}`, `{"a":1,"b":2}`, `{"a":2,"b":2}`, 0},
+		{"same change", `{"a":1}`, `{"a":2}`, `{"a":2}`, `{"a":2}`, 0},
+		{"conflicting changes", `{"a":1}`, `{"a":2}`, `{"a":3}`, `{"a":3}`, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patch, _ := JSONDiff([]byte(test.base), []byte(test.patched))
+			ontoPatch, _ := JSONDiff([]byte(test.base), []byte(test.onto))
+
+			rebasedPatch, conflicts, err := JSONRebasePatch([]byte(test.base), patch, ontoPatch)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(conflicts) != test.conflicts {
+				t.Errorf("got %d conflicts, want %d", len(conflicts), test.conflicts)
+			}
+
+			result, err := JSONApplyPatch([]byte(test.onto), rebasedPatch)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertEqualJSON(t, result, []byte(test.result))
+		})
+	}
+}
@@ -6,13 +6,15 @@
  *
  * This component provides the functionality compute differences between JSONs as well as apply patches.
  * The differences/patches are compliant to the https://datatracker.ietf.org/doc/html/rfc6902 standard.
+ * Alternatively, differences can be computed as merge patches, compliant to the https://datatracker.ietf.org/doc/html/rfc7396
+ * standard. Note that merge patches cannot set values to null, as null marks the removal of a member.
  * This component gladly uses the functionality provided by "github.com/evanphx/json-patch" and "github.com/wI2L/jsondiff"
  * Nevertheless, having our own Diff and Patch functions makes the rest of the code less dependent on potential changes to
  * the latter two packages.
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 29.11.2025
+ * Version of: 18.10.2026
  *
  */
 
@@ -45,6 +47,48 @@ func JSONApplyPatch(sourceJSON, patchJSON []byte) (json.RawMessage, error) {
 	return patch.Apply(sourceJSON)
 }
 
+// JSONMergeDiff computes the difference between two JSONs and returns it as a JSON Merge Patch.
+func JSONMergeDiff(sourceJSON, targetJSON []byte) (json.RawMessage, error) {
+	return jsonpatch.CreateMergePatch(sourceJSON, targetJSON)
+}
+
+// JSONApplyMergePatch applies a JSON Merge Patch to a source JSON and returns the resulting JSON.
+func JSONApplyMergePatch(sourceJSON, mergePatchJSON []byte) (json.RawMessage, error) {
+	return jsonpatch.MergePatch(sourceJSON, mergePatchJSON)
+}
+
+// JSONContainsNull checks whether the JSON contains a null value, which cannot be expressed in a JSON Merge Patch.
+func JSONContainsNull(message []byte) bool {
+	var value any
+	if json.Unmarshal(message, &value) != nil {
+		return false
+	}
+
+	return containsNull(value)
+}
+
+// Check whether a decoded JSON value is, or contains, null
+func containsNull(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case map[string]any:
+		for _, member := range value {
+			if containsNull(member) {
+				return true
+			}
+		}
+	case []any:
+		for _, element := range value {
+			if containsNull(element) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // IsJSON checks whether the message is a valid JSON.
 func IsJSON(message []byte) bool {
 	return json.Unmarshal(message, &json.RawMessage{}) == nil
@@ -0,0 +1,113 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: JSON Operations (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"testing"
+)
+
+func TestJSONDiffAndPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		target string
+	}{
+		{"unchanged", `{"a":1}`, `{"a":1}`},
+		{"replace", `{"a":1}`, `{"a":"x"}`},
+		{"add and remove", `{"a":1,"b":2}`, `{"a":1,"c":3}`},
+		{"arrays", `{"l":[1,2,3]}`, `{"l":[3,1]}`},
+		{"nulls", `{"a":1}`, `{"a":null,"b":null}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			patch, err := JSONDiff([]byte(test.source), []byte(test.target))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result, err := JSONApplyPatch([]byte(test.source), patch)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertEqualJSON(t, result, []byte(test.target))
+		})
+	}
+}
+
+func TestJSONMergeDiffAndPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		target string
+	}{
+		{"unchanged", `{"a":1}`, `{"a":1}`},
+		{"replace", `{"a":1}`, `{"a":"x"}`},
+		{"add and remove", `{"a":1,"b":2}`, `{"a":1,"c":3}`},
+		{"nested", `{"o":{"a":1,"b":2}}`, `{"o":{"a":1}}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mergePatch, err := JSONMergeDiff([]byte(test.source), []byte(test.target))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			result, err := JSONApplyMergePatch([]byte(test.source), mergePatch)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertEqualJSON(t, result, []byte(test.target))
+		})
+	}
+}
+
+func TestIsJSON(t *testing.T) {
+	tests := []struct {
+		message string
+		isJSON  bool
+	}{
+		{`{"a":1}`, true},
+		{`[1,2]`, true},
+		{`"text"`, true},
+		{`{`, false},
+		{``, false},
+	}
+
+	for _, test := range tests {
+		if isJSON := IsJSON([]byte(test.message)); isJSON != test.isJSON {
+			t.Errorf("IsJSON(%q) = %t, want %t", test.message, isJSON, test.isJSON)
+		}
+	}
+}
+
+func TestJSONContainsNull(t *testing.T) {
+	tests := []struct {
+		message      string
+		containsNull bool
+	}{
+		{`{"a":1,"b":[1,2]}`, false},
+		{`null`, true},
+		{`{"a":null}`, true},
+		{`{"o":{"l":[1,null]}}`, true},
+		{`{"a":"null"}`, false},
+		{`{`, false},
+	}
+
+	for _, test := range tests {
+		if containsNull := JSONContainsNull([]byte(test.message)); containsNull != test.containsNull {
+			t.Errorf("JSONContainsNull(%q) = %t, want %t", test.message, containsNull, test.containsNull)
+		}
+	}
+}
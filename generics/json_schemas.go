@@ -0,0 +1,115 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: JSON Schemas
+ *
+ * This component provides the functionality to validate JSONs against JSON Schemas (https://json-schema.org).
+ * This component gladly uses the functionality provided by "github.com/santhosh-tekuri/jsonschema".
+ * Nevertheless, having our own schema type and validation function makes the rest of the code less dependent
+ * on potential changes to the latter package.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+/*
+ * Defining JSON schemas
+ */
+
+type (
+	// A compiled JSON Schema
+	TJSONSchema struct {
+		schema *jsonschema.Schema // The compiled schema
+	}
+
+	// A violation of a JSON Schema
+	TJSONValidationError struct {
+		Path    string `json:"path"`    // JSON pointer (https://datatracker.ietf.org/doc/html/rfc6901) to the violating value
+		Message string `json:"message"` // Description of the violation
+	}
+)
+
+/*
+ * Collecting validation errors
+ */
+
+// Collect the most specific causes of a validation error
+func collectJSONValidationErrors(validationError *jsonschema.ValidationError, validationErrors *[]TJSONValidationError) {
+	if len(validationError.Causes) == 0 {
+		// The instance location is URL-encoded, while we report plain JSON pointers
+		path, err := url.PathUnescape(validationError.InstanceLocation)
+		if err != nil {
+			path = validationError.InstanceLocation
+		}
+		*validationErrors = append(*validationErrors, TJSONValidationError{path, validationError.Message})
+
+		return
+	}
+
+	for _, cause := range validationError.Causes {
+		collectJSONValidationErrors(cause, validationErrors)
+	}
+}
+
+/*
+ * Externally visible functionality
+ */
+
+// CompileJSONSchema compiles a JSON Schema, identified by the given name, so it can be used for validation.
+func CompileJSONSchema(schemaName string, schemaJSON []byte) (TJSONSchema, error) {
+	compiler := jsonschema.NewCompiler()
+
+	// Add the schema as a resource
+	err := compiler.AddResource(schemaName, bytes.NewReader(schemaJSON))
+	if err != nil {
+		return TJSONSchema{}, err
+	}
+
+	// Compile it
+	schema, err := compiler.Compile(schemaName)
+	if err != nil {
+		return TJSONSchema{}, err
+	}
+
+	return TJSONSchema{schema}, nil
+}
+
+// Validate validates a JSON against the schema, returning the violations found.
+// An error is returned when the JSON could not be validated at all, e.g. since it is not a valid JSON.
+func (s TJSONSchema) Validate(message []byte) ([]TJSONValidationError, error) {
+	validationErrors := []TJSONValidationError{}
+
+	// Decode the JSON
+	value, err := decodeJSONValue(message)
+	if err != nil {
+		return validationErrors, err
+	}
+
+	// Validate it
+	err = s.schema.Validate(value)
+	if err == nil {
+		return validationErrors, nil
+	}
+
+	// Collect the violations
+	var validationError *jsonschema.ValidationError
+	if !errors.As(err, &validationError) {
+		return validationErrors, err
+	}
+	collectJSONValidationErrors(validationError, &validationErrors)
+
+	return validationErrors, nil
+}
@@ -55,7 +55,7 @@ func (r *TReporter) Error(message string, context ...any) {
 
 // Reporting an error with an error value
 func (r *TReporter) ReportError(message string, err error) {
-	r.Error(message)
+	r.Error("%s", message)
 	r.Error("=> %s", err)
 }
 
@@ -74,7 +74,7 @@ func (r *TReporter) MaybeReportEmptyFlagError(flagValue *string, message string)
 	// Checking the flag value
 	if len(*flagValue) == 0 {
 		// Reporting the error if needed
-		r.Error(message)
+		r.Error("%s", message)
 
 		// Indicating that an error was reported
 		return true
@@ -5,18 +5,23 @@
  * Component: Timestamps
  *
  * This component computes unique (within the present run-time environment) timestamps.
- * The uniqueness is based on the current time up to seconds, and is combined with a counter
+ * The uniqueness is based on the current time up to seconds, and is combined with a counter.
+ * As the counter may exceed two digits, timestamps should be ordered using CompareTimestamps, rather than
+ * by comparing them as strings.
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 27.11.2025
+ * Version of: 18.10.2026
  *
  */
 
 package generics
 
 import (
+	"cmp"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -61,6 +66,40 @@ func GetTimestamp() string {
 	return fmt.Sprintf("%s-%02d", lastTimeTimestamp, timestampCounter)
 }
 
+// Splitting a timestamp in its time-based part and its counter
+func splitTimestamp(timestamp string) (string, int, bool) {
+	separator := strings.LastIndex(timestamp, "-")
+	if separator < 0 {
+		return timestamp, 0, false
+	}
+
+	counter, err := strconv.Atoi(timestamp[separator+1:])
+	if err != nil {
+		return timestamp, 0, false
+	}
+
+	return timestamp[:separator], counter, true
+}
+
+// Comparing two timestamps chronologically, returning -1, 0 or +1 when the first timestamp is respectively
+// before, equal to, or after the second one.
+// The time-based parts are compared as strings, while the counters are compared as numbers.
+func CompareTimestamps(timestamp1, timestamp2 string) int {
+	time1, counter1, ok1 := splitTimestamp(timestamp1)
+	time2, counter2, ok2 := splitTimestamp(timestamp2)
+
+	// Strings that are not timestamps can only be compared as strings
+	if !ok1 || !ok2 {
+		return strings.Compare(timestamp1, timestamp2)
+	}
+
+	if timeOrder := strings.Compare(time1, time2); timeOrder != 0 {
+		return timeOrder
+	}
+
+	return cmp.Compare(counter1, counter2)
+}
+
 // Initializing timestamp functionality
 func init() {
 	timestampCounter = 0
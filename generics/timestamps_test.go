@@ -0,0 +1,52 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Generic
+ * Component: Timestamps (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package generics
+
+import (
+	"testing"
+)
+
+func TestCompareTimestamps(t *testing.T) {
+	tests := []struct {
+		name       string
+		timestamp1 string
+		timestamp2 string
+		order      int
+	}{
+		{"equal", "2026-10-18-12-00-00-05", "2026-10-18-12-00-00-05", 0},
+		{"earlier second", "2026-10-18-12-00-00-05", "2026-10-18-12-00-01-00", -1},
+		{"later counter", "2026-10-18-12-00-00-10", "2026-10-18-12-00-00-09", 1},
+		{"counter beyond two digits", "2026-10-18-12-00-00-99", "2026-10-18-12-00-00-100", -1},
+		{"not a timestamp", "a", "b", -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if order := CompareTimestamps(test.timestamp1, test.timestamp2); order != test.order {
+				t.Errorf("got %d, want %d", order, test.order)
+			}
+		})
+	}
+}
+
+func TestGetTimestamp(t *testing.T) {
+	// Timestamps obtained one after the other should be chronologically ordered, also beyond a counter of 99
+	previousTimestamp := GetTimestamp()
+	for range 150 {
+		timestamp := GetTimestamp()
+		if CompareTimestamps(previousTimestamp, timestamp) >= 0 {
+			t.Fatalf("timestamp %s should be after %s", timestamp, previousTimestamp)
+		}
+		previousTimestamp = timestamp
+	}
+}
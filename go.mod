@@ -5,6 +5,7 @@ go 1.24.0
 require (
 	github.com/eclipse/paho.mqtt.golang v1.5.1
 	github.com/evanphx/json-patch v0.5.2
+	github.com/santhosh-tekuri/jsonschema/v5 v5.3.1
 	github.com/secsy/goftp v0.0.0-20200609142545-aa2de14babf4
 	github.com/wI2L/jsondiff v0.7.0
 	gopkg.in/ini.v1 v1.67.0
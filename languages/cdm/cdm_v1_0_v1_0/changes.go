@@ -0,0 +1,219 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1
+ * Component: Changes
+ *
+ * This component provides the semantic comparison of models expressed in the
+ *    Conceptual Domain Modelling language, Version 1
+ * Rather than a structural (JSON) difference, the comparison results in a set of changes in terms of the
+ * modelling language, such as types being added, removed or renamed, involvement types being added to or removed
+ * from relation types, and readings being added.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_0_v1_0
+
+/*
+ * Defining changes
+ */
+
+const (
+	ChangeModelRenamed           = "model renamed"            // The name of the model changed
+	ChangeTypeAdded              = "type added"               // A type was added
+	ChangeTypeRemoved            = "type removed"             // A type was removed
+	ChangeTypeRenamed            = "type renamed"             // A type was renamed
+	ChangeDomainChanged          = "domain changed"           // The domain of a quality type changed
+	ChangeBaseTypeChanged        = "base type changed"        // The base type of an involvement type changed
+	ChangeInvolvementTypeAdded   = "involvement type added"   // An involvement type was added to a relation type
+	ChangeInvolvementTypeRemoved = "involvement type removed" // An involvement type was removed from a relation type
+	ChangeReadingAdded           = "reading added"            // A reading was added to a relation type
+	ChangeReadingRemoved         = "reading removed"          // A reading was removed from a relation type
+	ChangeReadingChanged         = "reading changed"          // The definition of a reading changed
+	ChangePrimaryReadingChanged  = "primary reading changed"  // The primary reading of a relation type changed
+	ChangePrimaryReadingRemoved  = "primary reading removed"  // The primary reading of a relation type was removed, e.g. with the relation type itself
+
+	TypeKindConcreteIndividualType = "concrete individual type" // Concrete individual types
+	TypeKindQualityType            = "quality type"             // Quality types
+	TypeKindInvolvementType        = "involvement type"         // Involvement types
+	TypeKindRelationType           = "relation type"            // Relation types
+)
+
+type (
+	// A change between two versions of a model
+	TCDMChange struct {
+		Kind      string `json:"kind"`                 // The kind of change
+		TypeKind  string `json:"type kind,omitempty"`  // The kind of type involved, for changes of types
+		ElementID string `json:"element id"`           // The changed element; the relation type for changes of readings
+		ReadingID string `json:"reading id,omitempty"` // The reading involved, for changes of readings
+		OldValue  string `json:"old value,omitempty"`  // The old value (such as a name or domain), if applicable
+		NewValue  string `json:"new value,omitempty"`  // The new value, if applicable
+	}
+
+	// The changes of the current, updated and considered models
+	TCDMChangeSet struct {
+		Current    []TCDMChange `json:"current"`    // The changes of the current model
+		Updated    []TCDMChange `json:"updated"`    // The changes of the updated model
+		Considered []TCDMChange `json:"considered"` // The changes of the considered model
+	}
+)
+
+/*
+ * Comparing models
+ */
+
+// Getting the kind of a type
+func (m *TCDMModel) typeKind(id string) string {
+	switch {
+	case m.ConcreteIndividualTypes[id]:
+		return TypeKindConcreteIndividualType
+	case m.QualityTypes[id]:
+		return TypeKindQualityType
+	case m.InvolvementTypes[id]:
+		return TypeKindInvolvementType
+	case m.RelationTypes[id]:
+		return TypeKindRelationType
+	default:
+		return ""
+	}
+}
+
+// Comparing two reading definitions
+func sameReading(oldReading, newReading TRelationReading) bool {
+	if len(oldReading.InvolvementTypes) != len(newReading.InvolvementTypes) || len(oldReading.ReadingElements) != len(newReading.ReadingElements) {
+		return false
+	}
+	for i := range oldReading.InvolvementTypes {
+		if oldReading.InvolvementTypes[i] != newReading.InvolvementTypes[i] {
+			return false
+		}
+	}
+	for i := range oldReading.ReadingElements {
+		if oldReading.ReadingElements[i] != newReading.ReadingElements[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Comparing the types of two models
+func compareTypes(oldModel, newModel TCDMModel, changes *[]TCDMChange) {
+	// Removed types
+	for _, id := range sortedKeys(oldModel.TypeName) {
+		if _, exists := newModel.TypeName[id]; !exists {
+			*changes = append(*changes, TCDMChange{Kind: ChangeTypeRemoved, TypeKind: oldModel.typeKind(id), ElementID: id, OldValue: oldModel.TypeName[id]})
+		}
+	}
+
+	// Added and changed types
+	for _, id := range sortedKeys(newModel.TypeName) {
+		typeKind := newModel.typeKind(id)
+		oldName, existed := oldModel.TypeName[id]
+		newName := newModel.TypeName[id]
+
+		switch {
+		case !existed:
+			*changes = append(*changes, TCDMChange{Kind: ChangeTypeAdded, TypeKind: typeKind, ElementID: id, NewValue: newName})
+			continue
+		case oldName != newName:
+			*changes = append(*changes, TCDMChange{Kind: ChangeTypeRenamed, TypeKind: typeKind, ElementID: id, OldValue: oldName, NewValue: newName})
+		}
+
+		if oldDomain, newDomain := oldModel.DomainOfQualityType[id], newModel.DomainOfQualityType[id]; oldDomain != newDomain {
+			*changes = append(*changes, TCDMChange{Kind: ChangeDomainChanged, TypeKind: typeKind, ElementID: id, OldValue: oldDomain, NewValue: newDomain})
+		}
+		if oldBase, newBase := oldModel.BaseTypeOfInvolvementType[id], newModel.BaseTypeOfInvolvementType[id]; oldBase != newBase {
+			*changes = append(*changes, TCDMChange{Kind: ChangeBaseTypeChanged, TypeKind: typeKind, ElementID: id, OldValue: oldBase, NewValue: newBase})
+		}
+	}
+}
+
+// Comparing the involvement types of the relation types of two models, where the involvement types of added and
+// removed relation types are covered by the changes of the types
+func compareInvolvementTypes(oldModel, newModel TCDMModel, changes *[]TCDMChange) {
+	// Removed involvement types
+	for _, relationType := range sortedKeys(oldModel.InvolvementTypesOfRelationType) {
+		for _, involvementType := range sortedKeys(oldModel.InvolvementTypesOfRelationType[relationType]) {
+			if newModel.RelationTypes[relationType] && !newModel.InvolvementTypesOfRelationType[relationType][involvementType] {
+				*changes = append(*changes, TCDMChange{Kind: ChangeInvolvementTypeRemoved, TypeKind: TypeKindRelationType, ElementID: relationType, OldValue: involvementType})
+			}
+		}
+	}
+
+	// Added involvement types
+	for _, relationType := range sortedKeys(newModel.InvolvementTypesOfRelationType) {
+		for _, involvementType := range sortedKeys(newModel.InvolvementTypesOfRelationType[relationType]) {
+			if oldModel.RelationTypes[relationType] && !oldModel.InvolvementTypesOfRelationType[relationType][involvementType] {
+				*changes = append(*changes, TCDMChange{Kind: ChangeInvolvementTypeAdded, TypeKind: TypeKindRelationType, ElementID: relationType, NewValue: involvementType})
+			}
+		}
+	}
+}
+
+// Comparing the readings of two models
+func compareReadings(oldModel, newModel TCDMModel, changes *[]TCDMChange) {
+	// Removed readings
+	for _, relationType := range sortedKeys(oldModel.AlternativeReadingsOfRelationType) {
+		for _, reading := range sortedKeys(oldModel.AlternativeReadingsOfRelationType[relationType]) {
+			if !newModel.AlternativeReadingsOfRelationType[relationType][reading] {
+				*changes = append(*changes, TCDMChange{Kind: ChangeReadingRemoved, ElementID: relationType, ReadingID: reading})
+			}
+		}
+	}
+
+	// Removed primary readings, including those of removed relation types
+	for _, relationType := range sortedKeys(oldModel.PrimaryReadingOfRelationType) {
+		if oldPrimary := oldModel.PrimaryReadingOfRelationType[relationType]; oldPrimary != "" && newModel.PrimaryReadingOfRelationType[relationType] == "" {
+			*changes = append(*changes, TCDMChange{Kind: ChangePrimaryReadingRemoved, ElementID: relationType, ReadingID: oldPrimary, OldValue: oldPrimary})
+		}
+	}
+
+	// Added and changed readings
+	for _, relationType := range sortedKeys(newModel.AlternativeReadingsOfRelationType) {
+		for _, reading := range sortedKeys(newModel.AlternativeReadingsOfRelationType[relationType]) {
+			if !oldModel.AlternativeReadingsOfRelationType[relationType][reading] {
+				*changes = append(*changes, TCDMChange{Kind: ChangeReadingAdded, ElementID: relationType, ReadingID: reading})
+			} else if !sameReading(oldModel.ReadingDefinition[reading], newModel.ReadingDefinition[reading]) {
+				*changes = append(*changes, TCDMChange{Kind: ChangeReadingChanged, ElementID: relationType, ReadingID: reading})
+			}
+		}
+
+		// Changed primary readings
+		if oldPrimary, newPrimary := oldModel.PrimaryReadingOfRelationType[relationType], newModel.PrimaryReadingOfRelationType[relationType]; oldPrimary != newPrimary && newPrimary != "" && oldModel.RelationTypes[relationType] {
+			*changes = append(*changes, TCDMChange{Kind: ChangePrimaryReadingChanged, ElementID: relationType, ReadingID: newPrimary, OldValue: oldPrimary, NewValue: newPrimary})
+		}
+	}
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Comparing two versions of a model, returning the changes from the old to the new version
+func CompareModels(oldModel, newModel TCDMModel) []TCDMChange {
+	changes := []TCDMChange{}
+
+	// Compare the model names
+	if oldModel.ModelName != newModel.ModelName {
+		changes = append(changes, TCDMChange{Kind: ChangeModelRenamed, OldValue: oldModel.ModelName, NewValue: newModel.ModelName})
+	}
+
+	// Compare the types, involvement types and readings
+	compareTypes(oldModel, newModel, &changes)
+	compareInvolvementTypes(oldModel, newModel, &changes)
+	compareReadings(oldModel, newModel, &changes)
+
+	return changes
+}
+
+// Checking whether the change set contains any changes
+func (c TCDMChangeSet) IsEmpty() bool {
+	return len(c.Current) == 0 && len(c.Updated) == 0 && len(c.Considered) == 0
+}
@@ -0,0 +1,63 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1
+ * Component: Changes (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_0_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+func TestCompareModels(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "unchanged", Modify: func(m *TCDMModel, ids tTestModelIDs) {}, Kinds: []string{}},
+		{Name: "model renamed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetModelName("Jobs")
+		}, Kinds: []string{ChangeModelRenamed}},
+		{Name: "type added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddConcreteIndividualType("Department")
+		}, Kinds: []string{ChangeTypeAdded}},
+		{Name: "type removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveQualityType(ids.name)
+		}, Kinds: []string{ChangeTypeRemoved}},
+		{Name: "type renamed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RenameType(ids.company, "Organisation")
+		}, Kinds: []string{ChangeTypeRenamed}},
+		{Name: "domain changed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.ChangeQualityTypeDomain(ids.name, "text")
+		}, Kinds: []string{ChangeDomainChanged}},
+		{Name: "base type changed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.BaseTypeOfInvolvementType[ids.employer] = ids.person
+		}, Kinds: []string{ChangeBaseTypeChanged}},
+		{Name: "reading added and made primary", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetPrimaryReading(ids.worksFor, m.AddRelationTypeReading(ids.worksFor, "", ids.employer, "employs", ids.employee, ""))
+		}, Kinds: []string{ChangeReadingAdded, ChangePrimaryReadingChanged}},
+		{Name: "relation type removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveRelationType(ids.worksFor)
+		}, Kinds: []string{ChangeTypeRemoved, ChangeTypeRemoved, ChangeTypeRemoved, ChangeReadingRemoved, ChangePrimaryReadingRemoved}},
+		{Name: "involvement type added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			location := m.AddInvolvementType("location", ids.company)
+			m.InvolvementTypesOfRelationType[ids.worksFor][location] = true
+			m.RelationTypeOfInvolvementType[location] = ids.worksFor
+		}, Kinds: []string{ChangeTypeAdded, ChangeInvolvementTypeAdded}},
+		{Name: "involvement type removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			delete(m.InvolvementTypesOfRelationType[ids.worksFor], ids.employer)
+		}, Kinds: []string{ChangeInvolvementTypeRemoved}},
+		{Name: "reading changed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.ReadingDefinition[ids.reading] = TRelationReading{
+				InvolvementTypes: []string{ids.employee, ids.employer},
+				ReadingElements:  []string{"", "is employed by", ""},
+			}
+		}, Kinds: []string{ChangeReadingChanged}},
+	}, changeKindsAfter)
+}
@@ -224,6 +224,164 @@ func (m *TCDMModel) AddRelationTypeReading(relationType string, stringsAndInvolv
 	return readingID
 }
 
+/*
+ * Removing elements from CDM models
+ */
+
+// Removing the relation types in which a given type is involved
+func (m *TCDMModel) removeRelationTypesInvolving(typeID string) {
+	for involvementType, baseType := range m.BaseTypeOfInvolvementType {
+		if baseType == typeID {
+			m.RemoveRelationType(m.RelationTypeOfInvolvementType[involvementType])
+		}
+	}
+}
+
+// Removing a concrete individual type, as well as the relation types in which it is involved
+func (m *TCDMModel) RemoveConcreteIndividualType(id string) bool {
+	// Check that it is a concrete individual type
+	if !m.ConcreteIndividualTypes[id] {
+		return false
+	}
+
+	// Remove the concrete individual type
+	delete(m.ConcreteIndividualTypes, id)
+	delete(m.TypeName, id)
+
+	// Remove the relation types in which it is involved
+	m.removeRelationTypesInvolving(id)
+
+	return true
+}
+
+// Removing a quality type, as well as the relation types in which it is involved
+func (m *TCDMModel) RemoveQualityType(id string) bool {
+	// Check that it is a quality type
+	if !m.QualityTypes[id] {
+		return false
+	}
+
+	// Remove the quality type
+	delete(m.QualityTypes, id)
+	delete(m.TypeName, id)
+	delete(m.DomainOfQualityType, id)
+
+	// Remove the relation types in which it is involved
+	m.removeRelationTypesInvolving(id)
+
+	return true
+}
+
+// Removing a relation type, including its involvement types and readings, as well as the relation types in which it is involved
+func (m *TCDMModel) RemoveRelationType(id string) bool {
+	// Check that it is a relation type
+	if !m.RelationTypes[id] {
+		return false
+	}
+
+	// Remove the relation type
+	delete(m.RelationTypes, id)
+	delete(m.TypeName, id)
+
+	// Remove its involvement types
+	for involvementType := range m.InvolvementTypesOfRelationType[id] {
+		delete(m.InvolvementTypes, involvementType)
+		delete(m.TypeName, involvementType)
+		delete(m.BaseTypeOfInvolvementType, involvementType)
+		delete(m.RelationTypeOfInvolvementType, involvementType)
+	}
+	delete(m.InvolvementTypesOfRelationType, id)
+
+	// Remove its readings
+	for reading := range m.AlternativeReadingsOfRelationType[id] {
+		delete(m.ReadingDefinition, reading)
+	}
+	delete(m.AlternativeReadingsOfRelationType, id)
+	delete(m.PrimaryReadingOfRelationType, id)
+
+	// Remove the relation types in which it is involved
+	m.removeRelationTypesInvolving(id)
+
+	return true
+}
+
+// Removing a relation type reading, where another reading becomes the primary reading if needed
+func (m *TCDMModel) RemoveReading(readingID string) bool {
+	// Find the relation type of the reading
+	relationType, found := m.RelationTypeOfReading(readingID)
+	if !found {
+		return false
+	}
+
+	// Remove the reading
+	delete(m.AlternativeReadingsOfRelationType[relationType], readingID)
+	delete(m.ReadingDefinition, readingID)
+
+	// Select another primary reading, if needed
+	if m.PrimaryReadingOfRelationType[relationType] == readingID {
+		delete(m.PrimaryReadingOfRelationType, relationType)
+		if readings := sortedKeys(m.AlternativeReadingsOfRelationType[relationType]); len(readings) > 0 {
+			m.PrimaryReadingOfRelationType[relationType] = readings[0]
+		}
+	}
+
+	return true
+}
+
+/*
+ * Modifying elements of CDM models
+ */
+
+// Finding the relation type of a reading
+func (m *TCDMModel) RelationTypeOfReading(readingID string) (string, bool) {
+	for relationType, readings := range m.AlternativeReadingsOfRelationType {
+		if readings[readingID] {
+			return relationType, true
+		}
+	}
+
+	return "", false
+}
+
+// Renaming a type (of any kind)
+func (m *TCDMModel) RenameType(id, name string) bool {
+	// Check that the type exists
+	if _, exists := m.TypeName[id]; !exists {
+		return false
+	}
+
+	// Rename it
+	m.TypeName[id] = name
+
+	return true
+}
+
+// Changing the domain of a quality type
+func (m *TCDMModel) ChangeQualityTypeDomain(id, domain string) bool {
+	// Check that it is a quality type
+	if !m.QualityTypes[id] {
+		return false
+	}
+
+	// Change the domain
+	m.DomainOfQualityType[id] = domain
+
+	return true
+}
+
+// Setting the primary reading of a relation type, which should be one of its readings
+func (m *TCDMModel) SetPrimaryReading(relationType, readingID string) bool {
+	// Check that it is a reading of the relation type
+	if !m.AlternativeReadingsOfRelationType[relationType][readingID] {
+		return false
+	}
+
+	// Set the primary reading
+	m.PrimaryReadingOfRelationType[relationType] = readingID
+
+	return true
+}
+
 /*
  * Creating & cleaning CDM models
  */
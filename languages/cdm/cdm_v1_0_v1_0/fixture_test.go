@@ -0,0 +1,80 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1
+ * Component: Test model
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_0_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+type (
+	// The IDs of the elements of the test model
+	tTestModelIDs struct {
+		person, company, name, employee, employer, worksFor, reading string
+	}
+
+	// A test case modifying the test model
+	tKindsCase = cdmtest.TKindsCase[TCDMModel, tTestModelIDs]
+)
+
+// Creating a small, valid, test model
+func createTestModel() (TCDMModel, tTestModelIDs) {
+	ids := tTestModelIDs{}
+
+	model := CreateCDMModel(nil)
+	model.SetModelName("Employment")
+	ids.person = model.AddConcreteIndividualType("Person")
+	ids.company = model.AddConcreteIndividualType("Company")
+	ids.name = model.AddQualityType("Name", "string")
+	ids.employee = model.AddInvolvementType("employee", ids.person)
+	ids.employer = model.AddInvolvementType("employer", ids.company)
+	ids.worksFor = model.AddRelationType("Works for", ids.employee, ids.employer)
+	ids.reading = model.AddRelationTypeReading(ids.worksFor, "", ids.employee, "works for", ids.employer, "")
+
+	return model, ids
+}
+
+// Copying a model, so that the copy can be changed independently
+func copyTestModel(t *testing.T, model TCDMModel) TCDMModel {
+	t.Helper()
+
+	modelJSON, ok := model.GetModelAsJSON()
+	if !ok {
+		t.Fatalf("the model could not be converted to JSON")
+	}
+
+	modelCopy := CreateCDMModel(nil)
+	if !modelCopy.SetModelFromJSON(modelJSON) {
+		t.Fatalf("the model could not be converted from JSON")
+	}
+
+	return modelCopy
+}
+
+// Getting the kinds of the findings of validating the test model, after modifying it
+func findingKindsAfter(t *testing.T, modify func(*TCDMModel, tTestModelIDs)) []string {
+	model, ids := createTestModel()
+	modify(&model, ids)
+
+	return cdmtest.Kinds(model.Validate(), func(finding TCDMFinding) string { return finding.Kind })
+}
+
+// Getting the kinds of the changes resulting from modifying the test model
+func changeKindsAfter(t *testing.T, modify func(*TCDMModel, tTestModelIDs)) []string {
+	oldModel, ids := createTestModel()
+	newModel := copyTestModel(t, oldModel)
+	modify(&newModel, ids)
+
+	return cdmtest.Kinds(CompareModels(oldModel, newModel), func(change TCDMChange) string { return change.Kind })
+}
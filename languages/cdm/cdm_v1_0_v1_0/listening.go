@@ -28,11 +28,13 @@ import (
 
 type (
 	TCDMModelListener struct {
-		ModelListener connect.TModellingBusArtefactConnector
+		ModelListener connect.TListener[TCDMModel] // The typed listener used to listen for the models, and to report the findings of validating them
 
 		CurrentModel    TCDMModel
 		UpdatedModel    TCDMModel
 		ConsideredModel TCDMModel
+
+		Changes TCDMChangeSet // The changes of the models resulting from the latest posting received
 	}
 )
 
@@ -42,15 +44,26 @@ type (
 
 // Updating all models from the modelling bus
 func (l *TCDMModelListener) UpdateModelsFromBus() {
-	l.CurrentModel.SetModelFromJSON(l.ModelListener.CurrentContent)
-	l.UpdatedModel.SetModelFromJSON(l.ModelListener.UpdatedContent)
-	l.ConsideredModel.SetModelFromJSON(l.ModelListener.ConsideredContent)
+	// Keep the previous versions of the models, to determine the changes.
+	// The models obtained from the modelling bus have new maps, so the previous versions are not affected.
+	previousCurrentModel := l.CurrentModel
+	previousUpdatedModel := l.UpdatedModel
+	previousConsideredModel := l.ConsideredModel
+
+	l.CurrentModel.AdoptModel(l.ModelListener.Current())
+	l.UpdatedModel.AdoptModel(l.ModelListener.Updated())
+	l.ConsideredModel.AdoptModel(l.ModelListener.Considered())
+
+	// Determine the changes
+	l.Changes.Current = CompareModels(previousCurrentModel, l.CurrentModel)
+	l.Changes.Updated = CompareModels(previousUpdatedModel, l.UpdatedModel)
+	l.Changes.Considered = CompareModels(previousConsideredModel, l.ConsideredModel)
 }
 
 // Listening for model state postings on the modelling bus
 func (l *TCDMModelListener) ListenForModelStatePostings(agentID, modelID string, handler func()) {
 	// Setting up listening for model state postings
-	l.ModelListener.ListenForJSONArtefactStatePostings(agentID, modelID, func() {
+	l.ModelListener.ListenForStatePostings(agentID, modelID, func(TCDMModel) {
 		l.UpdateModelsFromBus()
 		handler()
 	})
@@ -59,7 +72,7 @@ func (l *TCDMModelListener) ListenForModelStatePostings(agentID, modelID string,
 // Listening for model update postings on the modelling bus
 func (l *TCDMModelListener) ListenForModelUpdatePostings(agentID, modelID string, handler func()) {
 	// Setting up listening for model update postings
-	l.ModelListener.ListenForJSONArtefactUpdatePostings(agentID, modelID, func() {
+	l.ModelListener.ListenForUpdatePostings(agentID, modelID, func(TCDMModel) {
 		l.UpdateModelsFromBus()
 		handler()
 	})
@@ -68,12 +81,26 @@ func (l *TCDMModelListener) ListenForModelUpdatePostings(agentID, modelID string
 // Listening for model considering postings on the modelling bus
 func (l *TCDMModelListener) ListenForModelConsideringPostings(agentID, modelID string, handler func()) {
 	// Setting up listening for model considering postings
-	l.ModelListener.ListenForJSONArtefactConsideringPostings(agentID, modelID, func() {
+	l.ModelListener.ListenForConsideringPostings(agentID, modelID, func(TCDMModel) {
 		l.UpdateModelsFromBus()
 		handler()
 	})
 }
 
+// Listening for changes of the models on the modelling bus, resulting from state, update and considering postings.
+// The handler is only called when the models actually changed.
+func (l *TCDMModelListener) ListenForModelChanges(agentID, modelID string, handler func(TCDMChangeSet)) {
+	changesHandler := func() {
+		if !l.Changes.IsEmpty() {
+			handler(l.Changes)
+		}
+	}
+
+	l.ListenForModelStatePostings(agentID, modelID, changesHandler)
+	l.ListenForModelUpdatePostings(agentID, modelID, changesHandler)
+	l.ListenForModelConsideringPostings(agentID, modelID, changesHandler)
+}
+
 /*
  *  Aggregate data across the model versions
  */
@@ -150,7 +177,7 @@ func (l *TCDMModelListener) AlternativeReadingsOfRelationType(relationType strin
 func CreateCDMListener(ModellingBusConnector connect.TModellingBusConnector, reporter *generics.TReporter) TCDMModelListener {
 	// Setting up a new CDM model listener
 	cdmModelListener := TCDMModelListener{}
-	cdmModelListener.ModelListener = connect.CreateModellingBusArtefactConnector(ModellingBusConnector, ModelJSONVersion, "")
+	cdmModelListener.ModelListener = connect.CreateListener[TCDMModel](ModellingBusConnector, ModelJSONVersion)
 	cdmModelListener.CurrentModel = CreateCDMModel(reporter)
 	cdmModelListener.UpdatedModel = CreateCDMModel(reporter)
 	cdmModelListener.ConsideredModel = CreateCDMModel(reporter)
@@ -10,7 +10,7 @@
  *
  * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
  *
- * Version of: 15.12.2025
+ * Version of: 18.10.2026
  *
  */
 
@@ -21,32 +21,25 @@ import (
 )
 
 /*
- * Definition of the CDM model listener
+ * Definition of the CDM model poster
  */
 
 type (
-	TCDMModelPoster struct {
-		modelPoster connect.TModellingBusArtefactConnector
-	}
+	// The poster of CDM models, which validates models before posting them when asked to (see UseValidation)
+	TCDMModelPoster = connect.TPoster[TCDMModel]
 )
 
 /*
- * Posting models to the modelling bus
+ * Adopting models from the modelling bus
  */
 
-// Posting the model's state
-func (p *TCDMModelPoster) PostState(m TCDMModel) {
-	p.modelPoster.PostJSONArtefactState(m.GetModelAsJSON())
-}
-
-// Posting the model's update
-func (p *TCDMModelPoster) PostUpdate(m TCDMModel) {
-	p.modelPoster.PostJSONArtefactUpdate(m.GetModelAsJSON())
-}
+// Setting the model to a model obtained from the modelling bus, while keeping the properties not posted on the bus
+func (m *TCDMModel) AdoptModel(model TCDMModel) {
+	model.reporter = m.reporter
+	model.ModelListener = m.ModelListener
+	model.InstanceIDCount = m.InstanceIDCount
 
-// Posting the model's considered update
-func (p *TCDMModelPoster) PostConsidering(m TCDMModel) {
-	p.modelPoster.PostJSONArtefactConsidering(m.GetModelAsJSON())
+	*m = model
 }
 
 /*
@@ -55,10 +48,5 @@ func (p *TCDMModelPoster) PostConsidering(m TCDMModel) {
 
 // Creating a CDM model poster, which uses a given ModellingBusConnector to post the model
 func CreateCDMPoster(ModellingBusConnector connect.TModellingBusConnector, modelID string) TCDMModelPoster {
-	// Setting up new CDM model poster
-	cdmPosterModel := TCDMModelPoster{}
-	cdmPosterModel.modelPoster = connect.CreateModellingBusArtefactConnector(ModellingBusConnector, ModelJSONVersion, modelID)
-
-	// Return the created CDM model poster
-	return cdmPosterModel
+	return connect.CreatePoster[TCDMModel](ModellingBusConnector, ModelJSONVersion, modelID)
 }
@@ -0,0 +1,239 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1
+ * Component: Validation
+ *
+ * This component provides the consistency validation of models expressed in the
+ *    Conceptual Domain Modelling language, Version 1
+ * Where the JSON schema only covers the structure of models, this validation covers the consistency of the
+ * references between the elements of a model, such as the involvement types used in readings.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_0_v1_0
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+)
+
+/*
+ * Defining findings
+ */
+
+const (
+	FindingDanglingReference = "dangling reference" // An element refers to an element that does not exist (as such)
+	FindingReadingArity      = "reading arity"      // A reading does not use the involvement types of its relation type exactly once
+	FindingMissingReading    = "missing reading"    // A relation type has no readings
+	FindingMissingDomain     = "missing domain"     // A quality type has no domain
+	FindingDuplicateName     = "duplicate name"     // Several types have the same name
+)
+
+type (
+	// A finding of the validation of a model
+	TCDMFinding = connect.TFinding
+)
+
+/*
+ * Collecting findings
+ */
+
+// Getting the keys of a set, in sorted order, so the findings are reported in a predictable order
+func sortedKeys[V any](set map[string]V) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Adding a finding
+func addFinding(findings *[]TCDMFinding, kind, elementID, message string, context ...any) {
+	*findings = append(*findings, TCDMFinding{Kind: kind, ElementID: elementID, Message: fmt.Sprintf(message, context...)})
+}
+
+// Checking whether an ID refers to a type that can play a role in a relation type
+func (m *TCDMModel) isBaseType(id string) bool {
+	return m.ConcreteIndividualTypes[id] || m.QualityTypes[id] || m.RelationTypes[id]
+}
+
+/*
+ * Validating the parts of a model
+ */
+
+// Validating the quality types
+func (m *TCDMModel) validateQualityTypes(findings *[]TCDMFinding) {
+	for _, qualityType := range sortedKeys(m.QualityTypes) {
+		if m.DomainOfQualityType[qualityType] == "" {
+			addFinding(findings, FindingMissingDomain, qualityType, "quality type %q has no domain", m.TypeName[qualityType])
+		}
+	}
+}
+
+// Validating the involvement types
+func (m *TCDMModel) validateInvolvementTypes(findings *[]TCDMFinding) {
+	for _, involvementType := range sortedKeys(m.InvolvementTypes) {
+		// The base type should exist
+		baseType := m.BaseTypeOfInvolvementType[involvementType]
+		if !m.isBaseType(baseType) {
+			addFinding(findings, FindingDanglingReference, involvementType, "involvement type %q has unknown base type %q", m.TypeName[involvementType], baseType)
+		}
+
+		// The relation type should exist, and include the involvement type
+		relationType := m.RelationTypeOfInvolvementType[involvementType]
+		if !m.RelationTypes[relationType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "involvement type %q has unknown relation type %q", m.TypeName[involvementType], relationType)
+		} else if !m.InvolvementTypesOfRelationType[relationType][involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "involvement type %q is not an involvement type of its relation type %q", m.TypeName[involvementType], m.TypeName[relationType])
+		}
+	}
+
+	// No base or relation types for unknown involvement types
+	for _, involvementType := range sortedKeys(m.BaseTypeOfInvolvementType) {
+		if !m.InvolvementTypes[involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "base type given for unknown involvement type %q", involvementType)
+		}
+	}
+	for _, involvementType := range sortedKeys(m.RelationTypeOfInvolvementType) {
+		if !m.InvolvementTypes[involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "relation type given for unknown involvement type %q", involvementType)
+		}
+	}
+}
+
+// Validating a reading of a relation type
+func (m *TCDMModel) validateReading(relationType, reading string, findings *[]TCDMFinding) {
+	// The reading should be defined
+	readingDefinition, defined := m.ReadingDefinition[reading]
+	if !defined {
+		addFinding(findings, FindingDanglingReference, reading, "relation type %q has undefined reading %q", m.TypeName[relationType], reading)
+		return
+	}
+
+	// Each involvement type of the relation type should be used exactly once
+	used := map[string]int{}
+	for _, involvementType := range readingDefinition.InvolvementTypes {
+		used[involvementType]++
+		if !m.InvolvementTypesOfRelationType[relationType][involvementType] {
+			addFinding(findings, FindingReadingArity, reading, "reading of relation type %q uses involvement type %q of another relation type", m.TypeName[relationType], involvementType)
+		}
+	}
+	for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+		if used[involvementType] != 1 {
+			addFinding(findings, FindingReadingArity, reading, "reading of relation type %q uses involvement type %q %d times", m.TypeName[relationType], m.TypeName[involvementType], used[involvementType])
+		}
+	}
+
+	// The reading elements should surround the involvement types
+	if len(readingDefinition.ReadingElements) != len(readingDefinition.InvolvementTypes)+1 {
+		addFinding(findings, FindingReadingArity, reading, "reading of relation type %q has %d reading elements for %d involvement types", m.TypeName[relationType], len(readingDefinition.ReadingElements), len(readingDefinition.InvolvementTypes))
+	}
+}
+
+// Validating the relation types
+func (m *TCDMModel) validateRelationTypes(findings *[]TCDMFinding) {
+	for _, relationType := range sortedKeys(m.RelationTypes) {
+		// The involvement types should exist, and refer back to the relation type
+		for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+			if !m.InvolvementTypes[involvementType] {
+				addFinding(findings, FindingDanglingReference, relationType, "relation type %q has unknown involvement type %q", m.TypeName[relationType], involvementType)
+			} else if m.RelationTypeOfInvolvementType[involvementType] != relationType {
+				addFinding(findings, FindingDanglingReference, relationType, "involvement type %q of relation type %q belongs to another relation type", m.TypeName[involvementType], m.TypeName[relationType])
+			}
+		}
+
+		// There should be readings
+		readings := m.AlternativeReadingsOfRelationType[relationType]
+		if len(readings) == 0 {
+			addFinding(findings, FindingMissingReading, relationType, "relation type %q has no readings", m.TypeName[relationType])
+		}
+		for _, reading := range sortedKeys(readings) {
+			m.validateReading(relationType, reading, findings)
+		}
+
+		// The primary reading should be one of the readings
+		primaryReading := m.PrimaryReadingOfRelationType[relationType]
+		if primaryReading != "" && !readings[primaryReading] {
+			addFinding(findings, FindingDanglingReference, relationType, "the primary reading %q of relation type %q is not one of its readings", primaryReading, m.TypeName[relationType])
+		}
+	}
+}
+
+// Validating the names of the types
+func (m *TCDMModel) validateNames(findings *[]TCDMFinding) {
+	// The concrete individual, quality and relation types should have different names
+	typeWithName := map[string]string{}
+	for _, typeID := range sortedKeys(m.TypeName) {
+		if !m.isBaseType(typeID) {
+			continue
+		}
+
+		name := m.TypeName[typeID]
+		if otherType, taken := typeWithName[name]; taken {
+			addFinding(findings, FindingDuplicateName, typeID, "types %s and %s are both named %q", otherType, typeID, name)
+		} else {
+			typeWithName[name] = typeID
+		}
+	}
+
+	// The involvement types of a relation type should have different names
+	for _, relationType := range sortedKeys(m.RelationTypes) {
+		involvementTypeWithName := map[string]string{}
+		for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+			name := m.TypeName[involvementType]
+			if _, taken := involvementTypeWithName[name]; taken {
+				addFinding(findings, FindingDuplicateName, involvementType, "relation type %q has several involvement types named %q", m.TypeName[relationType], name)
+			} else {
+				involvementTypeWithName[name] = involvementType
+			}
+		}
+	}
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Validating the consistency of the model, returning the findings
+func (m *TCDMModel) Validate() []TCDMFinding {
+	findings := []TCDMFinding{}
+
+	m.validateQualityTypes(&findings)
+	m.validateInvolvementTypes(&findings)
+	m.validateRelationTypes(&findings)
+	m.validateNames(&findings)
+
+	return findings
+}
+
+// Validating the consistency of a model given as JSON, returning the findings as strings.
+// This is the validator used for the language registry.
+func ValidateModelJSON(modelJSON []byte) []string {
+	messages := []string{}
+
+	// Convert the JSON to a model
+	model := TCDMModel{}
+	model.Clean()
+	if err := json.Unmarshal(modelJSON, &model); err != nil {
+		return append(messages, err.Error())
+	}
+
+	// Validate the model
+	for _, finding := range model.Validate() {
+		messages = append(messages, finding.Kind+": "+finding.Message)
+	}
+
+	return messages
+}
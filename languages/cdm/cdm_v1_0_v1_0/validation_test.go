@@ -0,0 +1,60 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1
+ * Component: Validation (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_0_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+func TestValidate(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "valid model", Modify: func(m *TCDMModel, ids tTestModelIDs) {}, Kinds: []string{}},
+		{Name: "missing domain", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.DomainOfQualityType[ids.name] = ""
+		}, Kinds: []string{FindingMissingDomain}},
+		{Name: "unknown base type", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.BaseTypeOfInvolvementType[ids.employee] = "unknown"
+		}, Kinds: []string{FindingDanglingReference}},
+		{Name: "missing reading", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			delete(m.AlternativeReadingsOfRelationType[ids.worksFor], ids.reading)
+			delete(m.ReadingDefinition, ids.reading)
+			delete(m.PrimaryReadingOfRelationType, ids.worksFor)
+		}, Kinds: []string{FindingMissingReading}},
+		{Name: "reading arity", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddRelationTypeReading(ids.worksFor, "", ids.employee, "is employed")
+		}, Kinds: []string{FindingReadingArity}},
+		{Name: "duplicate name", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.TypeName[ids.company] = "Person"
+		}, Kinds: []string{FindingDuplicateName}},
+		{Name: "duplicate involvement type name", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.TypeName[ids.employer] = "employee"
+		}, Kinds: []string{FindingDuplicateName}},
+	}, findingKindsAfter)
+}
+
+func TestValidateModelJSON(t *testing.T) {
+	model, _ := createTestModel()
+	modelJSON, ok := model.GetModelAsJSON()
+	if !ok {
+		t.Fatalf("the model could not be converted to JSON")
+	}
+
+	if messages := ValidateModelJSON(modelJSON); len(messages) != 0 {
+		t.Errorf("got %v, want no findings", messages)
+	}
+	if messages := ValidateModelJSON([]byte(`{`)); len(messages) == 0 {
+		t.Errorf("expected findings for invalid JSON")
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Changes (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+func TestCompareModels(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "unchanged", Modify: func(m *TCDMModel, ids tTestModelIDs) {}, Kinds: []string{}},
+		{Name: "type renamed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RenameType(ids.company, "Organisation")
+		}, Kinds: []string{ChangeTypeRenamed}},
+		{Name: "relation type removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveRelationType(ids.worksFor)
+		}, Kinds: []string{ChangeTypeRemoved, ChangeTypeRemoved, ChangeTypeRemoved, ChangeReadingRemoved, ChangePrimaryReadingRemoved}},
+		{Name: "involvement type added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			location := m.AddInvolvementType("location", ids.company)
+			m.InvolvementTypesOfRelationType[ids.worksFor][location] = true
+			m.RelationTypeOfInvolvementType[location] = ids.worksFor
+		}, Kinds: []string{ChangeTypeAdded, ChangeInvolvementTypeAdded}},
+		{Name: "involvement type removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			delete(m.InvolvementTypesOfRelationType[ids.worksFor], ids.employer)
+		}, Kinds: []string{ChangeInvolvementTypeRemoved}},
+		{Name: "subtyping added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddSubtyping(ids.company, m.AddConcreteIndividualType("Legal entity"))
+		}, Kinds: []string{ChangeTypeAdded, ChangeSubtypingAdded}},
+		{Name: "subtyping removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveSubtyping(ids.employee, ids.person)
+		}, Kinds: []string{ChangeSubtypingRemoved}},
+	}, changeKindsAfter)
+}
+
+func TestCompareConstraints(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "constraint added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddUniquenessConstraint(ids.worker)
+		}, Kinds: []string{ChangeConstraintAdded}},
+		{Name: "mandatory changed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetMandatory(ids.worker, true)
+		}, Kinds: []string{ChangeMandatoryChanged}},
+		{Name: "frequency changed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetFrequency(ids.employer, 1, 3)
+		}, Kinds: []string{ChangeFrequencyChanged}},
+		{Name: "values changed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetValueConstraint(ids.name, "Alice", "Bob")
+		}, Kinds: []string{ChangeValuesChanged}},
+	}, changeKindsAfter)
+
+	// Removing a constraint requires a model with a constraint to start with
+	model, ids := createTestModel()
+	constraint := model.AddUniquenessConstraint(ids.worker, ids.employer)
+	cdmtest.AssertKinds(t, changeKinds(t, model, ids, func(m *TCDMModel, ids tTestModelIDs) {
+		m.RemoveUniquenessConstraint(constraint)
+	}), []string{ChangeConstraintRemoved})
+}
+
+func TestComparePopulations(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "unchanged", Modify: func(m *TCDMModel, ids tTestModelIDs) {}, Kinds: []string{}},
+		{Name: "instance added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddInstance(ids.employee, "Bob")
+		}, Kinds: []string{ChangeInstanceAdded}},
+		{Name: "instance removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveInstance(ids.alice)
+		}, Kinds: []string{ChangeInstanceRemoved, ChangeFactRemoved}},
+		{Name: "instance relabelled", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.LabelOfInstance[ids.acme] = "Acme Corporation"
+		}, Kinds: []string{ChangeInstanceRelabelled}},
+		{Name: "fact added", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddFact(ids.worksFor, map[string]string{ids.worker: m.AddInstance(ids.employee, "Bob"), ids.employer: ids.acme})
+		}, Kinds: []string{ChangeInstanceAdded, ChangeFactAdded}},
+		{Name: "fact removed", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveFact(ids.aliceWorksForAcme)
+		}, Kinds: []string{ChangeFactRemoved}},
+	}, populatedChangeKindsAfter)
+}
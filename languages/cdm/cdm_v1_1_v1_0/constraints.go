@@ -0,0 +1,157 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Constraints
+ *
+ * This component provides the constraints of models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * These are uniqueness constraints over the involvement types of a relation type, mandatory involvement
+ * types, frequency constraints on involvement types, and value constraints on the domains of quality types.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+/*
+ * Removing constraints
+ */
+
+// Removing the constraints on an involvement type, where uniqueness constraints spanning it are removed as a whole
+func (m *TCDMModel) removeConstraintsOn(involvementType string) {
+	for constraint, involvementTypes := range m.InvolvementTypesOfUniquenessConstraint {
+		if involvementTypes[involvementType] {
+			m.RemoveUniquenessConstraint(constraint)
+		}
+	}
+	delete(m.MandatoryInvolvementTypes, involvementType)
+	delete(m.FrequencyOfInvolvementType, involvementType)
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Adding a uniqueness constraint, spanning one or more involvement types of the same relation type.
+// Returns the ID of the constraint, or an empty string when the involvement types do not qualify.
+func (m *TCDMModel) AddUniquenessConstraint(involvementTypes ...string) string {
+	// Check that the involvement types belong to the same relation type
+	if len(involvementTypes) == 0 {
+		return ""
+	}
+	relationType := m.RelationTypeOfInvolvementType[involvementTypes[0]]
+	for _, involvementType := range involvementTypes {
+		if !m.InvolvementTypes[involvementType] || m.RelationTypeOfInvolvementType[involvementType] != relationType {
+			return ""
+		}
+	}
+
+	// Settings things up for a new uniqueness constraint
+	id := m.NewElementID()
+	m.UniquenessConstraints[id] = true
+	m.InvolvementTypesOfUniquenessConstraint[id] = map[string]bool{}
+	for _, involvementType := range involvementTypes {
+		m.InvolvementTypesOfUniquenessConstraint[id][involvementType] = true
+	}
+
+	// Return the new constraint ID
+	return id
+}
+
+// Removing a uniqueness constraint
+func (m *TCDMModel) RemoveUniquenessConstraint(id string) bool {
+	// Check that it is a uniqueness constraint
+	if !m.UniquenessConstraints[id] {
+		return false
+	}
+
+	// Remove the uniqueness constraint
+	delete(m.UniquenessConstraints, id)
+	delete(m.InvolvementTypesOfUniquenessConstraint, id)
+
+	return true
+}
+
+// Setting whether an involvement type is mandatory for all instances of its base type
+func (m *TCDMModel) SetMandatory(involvementType string, mandatory bool) bool {
+	// Check that it is an involvement type
+	if !m.InvolvementTypes[involvementType] {
+		return false
+	}
+
+	// Set or clear the mandatory constraint
+	if mandatory {
+		m.MandatoryInvolvementTypes[involvementType] = true
+	} else {
+		delete(m.MandatoryInvolvementTypes, involvementType)
+	}
+
+	return true
+}
+
+// Setting the frequency constraint of an involvement type, i.e. the minimal and maximal number of times an
+// instance can play it, where a maximum of 0 means there is no maximum
+func (m *TCDMModel) SetFrequency(involvementType string, minimum, maximum int) bool {
+	// Check that it is an involvement type, and that the frequency makes sense
+	if !m.InvolvementTypes[involvementType] || minimum < 1 || (maximum != 0 && maximum < minimum) {
+		return false
+	}
+
+	// Set the frequency constraint
+	m.FrequencyOfInvolvementType[involvementType] = TFrequency{minimum, maximum}
+
+	return true
+}
+
+// Removing the frequency constraint of an involvement type
+func (m *TCDMModel) RemoveFrequency(involvementType string) bool {
+	// Check that there is a frequency constraint
+	if _, constrained := m.FrequencyOfInvolvementType[involvementType]; !constrained {
+		return false
+	}
+
+	// Remove it
+	delete(m.FrequencyOfInvolvementType, involvementType)
+
+	return true
+}
+
+// Setting the allowed values of a quality type, where no values removes the value constraint
+func (m *TCDMModel) SetValueConstraint(qualityType string, values ...string) bool {
+	// Check that it is a quality type
+	if !m.QualityTypes[qualityType] {
+		return false
+	}
+
+	// Set or clear the value constraint
+	if len(values) > 0 {
+		m.ValuesOfQualityType[qualityType] = append([]string{}, values...)
+	} else {
+		delete(m.ValuesOfQualityType, qualityType)
+	}
+
+	return true
+}
+
+// Checking whether a value is allowed for a quality type
+func (m *TCDMModel) IsAllowedValue(qualityType, value string) bool {
+	// Without a value constraint, all values are allowed
+	values, constrained := m.ValuesOfQualityType[qualityType]
+	if !constrained {
+		return true
+	}
+
+	for _, allowedValue := range values {
+		if allowedValue == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Constraints (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+func TestAddingConstraints(t *testing.T) {
+	model, ids := createTestModel()
+	member := model.AddInvolvementType("member", ids.employee)
+	isMember := model.AddRelationType("Is member", member)
+	model.AddRelationTypeReading(isMember, "", member, "is a member")
+
+	cdmtest.RunValidityCases(t, []cdmtest.TValidityCase{
+		{Name: "uniqueness constraint", Try: func() bool { return model.AddUniquenessConstraint(ids.worker) != "" }, Valid: true},
+		{Name: "uniqueness constraint without involvement types", Try: func() bool { return model.AddUniquenessConstraint() != "" }, Valid: false},
+		{Name: "uniqueness constraint over several relation types", Try: func() bool { return model.AddUniquenessConstraint(ids.worker, member) != "" }, Valid: false},
+		{Name: "mandatory constraint", Try: func() bool { return model.SetMandatory(ids.worker, true) }, Valid: true},
+		{Name: "mandatory constraint on unknown involvement type", Try: func() bool { return model.SetMandatory(ids.person, true) }, Valid: false},
+		{Name: "frequency constraint", Try: func() bool { return model.SetFrequency(ids.employer, 1, 3) }, Valid: true},
+		{Name: "frequency constraint without maximum", Try: func() bool { return model.SetFrequency(ids.employer, 2, 0) }, Valid: true},
+		{Name: "frequency constraint below one", Try: func() bool { return model.SetFrequency(ids.employer, 0, 3) }, Valid: false},
+		{Name: "frequency constraint with maximum below minimum", Try: func() bool { return model.SetFrequency(ids.employer, 3, 2) }, Valid: false},
+		{Name: "value constraint", Try: func() bool { return model.SetValueConstraint(ids.name, "Alice", "Bob") }, Valid: true},
+		{Name: "value constraint on concrete individual type", Try: func() bool { return model.SetValueConstraint(ids.person, "Alice") }, Valid: false},
+	})
+
+	cdmtest.AssertKinds(t, findingKinds(model), []string{})
+}
+
+func TestIsAllowedValue(t *testing.T) {
+	model, ids := createTestModel()
+
+	if !model.IsAllowedValue(ids.name, "Carol") {
+		t.Errorf("without a value constraint, all values should be allowed")
+	}
+
+	model.SetValueConstraint(ids.name, "Alice", "Bob")
+	if !model.IsAllowedValue(ids.name, "Alice") || model.IsAllowedValue(ids.name, "Carol") {
+		t.Errorf("only the values of the value constraint should be allowed")
+	}
+
+	model.SetValueConstraint(ids.name)
+	if !model.IsAllowedValue(ids.name, "Carol") {
+		t.Errorf("removing the value constraint should allow all values again")
+	}
+}
+
+func TestRemovingConstraints(t *testing.T) {
+	model, ids := createTestModel()
+	model.AddUniquenessConstraint(ids.worker, ids.employer)
+	model.SetMandatory(ids.worker, true)
+	model.SetFrequency(ids.worker, 1, 2)
+
+	// Removing a relation type removes the constraints on its involvement types
+	model.RemoveRelationType(ids.worksFor)
+	if len(model.UniquenessConstraints) != 0 || len(model.MandatoryInvolvementTypes) != 0 || len(model.FrequencyOfInvolvementType) != 0 {
+		t.Errorf("the constraints on the removed relation type should have been removed")
+	}
+	if model.RemoveFrequency(ids.worker) {
+		t.Errorf("there should be no frequency constraint left to remove")
+	}
+}
+
+func TestConstraintViolations(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "no violations", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddUniquenessConstraint(ids.worker)
+			m.SetMandatory(ids.worker, true)
+			m.SetFrequency(ids.employer, 1, 0)
+		}, Kinds: []string{}},
+		{Name: "uniqueness violation", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.AddUniquenessConstraint(ids.worker)
+			globex := m.AddInstance(ids.company, "Globex")
+			m.AddFact(ids.worksFor, map[string]string{ids.worker: ids.alice, ids.employer: globex})
+		}, Kinds: []string{FindingViolation}},
+		{Name: "mandatory violation", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetMandatory(ids.worker, true)
+			m.AddInstance(ids.employee, "Bob")
+		}, Kinds: []string{FindingViolation}},
+		{Name: "frequency violation", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SetFrequency(ids.employer, 2, 0)
+		}, Kinds: []string{FindingViolation}},
+	}, populatedFindingKindsAfter)
+}
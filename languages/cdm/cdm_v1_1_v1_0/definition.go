@@ -0,0 +1,569 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Definition
+ *
+ * This component provides the core fefinitions of the
+ *    Conceptual Domain Modelling language, Version 1.1
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"encoding/json"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining key constants
+ */
+
+const (
+	// The JSON version identifier for CDM models:
+	// - Meta model version 1.1
+	// - JSON version 1.0
+	ModelJSONVersion = "cdm-v1.1-v1.0" // The JSON version identifier for CDM v1.1-v1.0 models
+)
+
+/*
+ * Defining the CDM model structure, including the JSON structure
+ */
+
+type (
+	// Definition of a relation type reading
+	TRelationReading struct {
+		InvolvementTypes []string `json:"involvement types"` // The involvement types used in the relation type readings
+		ReadingElements  []string `json:"reading elements"`  // The strings used in relation type reading
+	}
+
+	// Definition of a frequency constraint, where a maximum of 0 means there is no maximum
+	TFrequency struct {
+		Minimum int `json:"minimum"` // The minimal number of times an instance plays the involvement type
+		Maximum int `json:"maximum"` // The maximal number of times an instance plays the involvement type
+	}
+
+	// Definition of the CDM model structure
+	TCDMModel struct {
+		// For reporting errors
+		reporter *generics.TReporter // The Reporter to be used to report progress, errors, and panics
+
+		// For posting of, and listening to, model updates on the modelling bus
+		ModelListener connect.TModellingBusArtefactConnector `json:"-"` // The Modelling Bus Artefact Poster used to listen for updates of the model
+
+		// General properties for the model
+		ModelName string `json:"model name"` // The name of the model
+
+		// For types
+		TypeName map[string]string `json:"type names"` // The names of the types, by their IDs
+
+		// For concrete individual types
+		ConcreteIndividualTypes map[string]bool `json:"concrete individual types"` // The concrete individual types
+
+		// For subtyping of concrete individual types
+		SupertypesOfConcreteIndividualType map[string]map[string]bool `json:"supertypes of concrete individual types"` // The direct supertypes of each concrete individual type
+
+		// For quality types
+		QualityTypes        map[string]bool   `json:"quality types"`            // The quality types
+		DomainOfQualityType map[string]string `json:"domains of quality types"` // The domain of each quality type
+
+		// For involvement types
+		InvolvementTypes              map[string]bool   `json:"involvement types"`                   // The involvement types
+		BaseTypeOfInvolvementType     map[string]string `json:"base types of involvement types"`     // The base type of each involvement type
+		RelationTypeOfInvolvementType map[string]string `json:"relation types of involvement types"` // The relation type of each involvement type
+
+		// For relation types
+		RelationTypes                     map[string]bool             `json:"relation types"`                         // The relation types
+		InvolvementTypesOfRelationType    map[string]map[string]bool  `json:"involvement types of relation types"`    // The involvement types of each relation type
+		AlternativeReadingsOfRelationType map[string]map[string]bool  `json:"alternative readings of relation types"` // The alternative readings of each relation type
+		PrimaryReadingOfRelationType      map[string]string           `json:"primary readings of relation types"`     // The primary reading of each relation type
+		ReadingDefinition                 map[string]TRelationReading `json:"reading definition"`                     // The definition of each relation type reading
+
+		// For constraints
+		UniquenessConstraints                  map[string]bool            `json:"uniqueness constraints"`                      // The uniqueness constraints
+		InvolvementTypesOfUniquenessConstraint map[string]map[string]bool `json:"involvement types of uniqueness constraints"` // The involvement types spanned by each uniqueness constraint
+		MandatoryInvolvementTypes              map[string]bool            `json:"mandatory involvement types"`                 // The involvement types that must be played by all instances of their base type
+		FrequencyOfInvolvementType             map[string]TFrequency      `json:"frequencies of involvement types"`            // The frequency constraint of each involvement type
+		ValuesOfQualityType                    map[string][]string        `json:"values of quality types"`                     // The allowed values of each quality type
+
+		// For the population
+		Instances          map[string]bool              `json:"instances"`               // The instances of concrete individual types
+		TypeOfInstance     map[string]string            `json:"types of instances"`      // The concrete individual type of each instance
+		LabelOfInstance    map[string]string            `json:"labels of instances"`     // The label of each instance, as used in verbalisations
+		Facts              map[string]bool              `json:"facts"`                   // The facts, i.e. the instances of relation types
+		RelationTypeOfFact map[string]string            `json:"relation types of facts"` // The relation type of each fact
+		FillersOfFact      map[string]map[string]string `json:"fillers of facts"`        // The filler of each involvement type of each fact
+	}
+)
+
+/*
+ * Converting JSON to models and back
+ */
+
+// Converting the model to JSON
+func (m *TCDMModel) GetModelAsJSON() (json.RawMessage, bool) {
+	// Converting the model to JSON
+	json, err := json.Marshal(m)
+
+	// Handle potential errors
+	if m.reporter.MaybeReportError("Something went wrong when converting model to JSON.", err) {
+		return []byte{}, false
+	}
+
+	return json, true
+}
+
+// Converting the JSON to the model
+func (m *TCDMModel) SetModelFromJSON(modelJSON json.RawMessage) bool {
+	m.Clean()
+	err := json.Unmarshal(modelJSON, m)
+
+	// Handle potential errors
+	if m.reporter.MaybeReportError("Something went wrong when converting JSON to model.", err) {
+		return false
+	}
+
+	return true
+}
+
+/*
+ * Functionality related to the CDM model
+ */
+
+// Generating a new element ID
+func (m *TCDMModel) NewElementID() string {
+	// Generating a new element ID based on timestamps
+	return generics.GetTimestamp()
+}
+
+// Setting the model name
+func (m *TCDMModel) SetModelName(name string) {
+	// Setting the model name
+	m.ModelName = name
+}
+
+// Adding a concrete individual type
+func (m *TCDMModel) AddConcreteIndividualType(name string) string {
+	// Settings things up for a new concrete individual type
+	id := m.NewElementID()
+	m.ConcreteIndividualTypes[id] = true
+	m.TypeName[id] = name
+
+	// Return the new type ID
+	return id
+}
+
+// Adding a quality type
+func (m *TCDMModel) AddQualityType(name, domain string) string {
+	// Settings things up for a new quality type
+	id := m.NewElementID()
+	m.QualityTypes[id] = true
+	m.TypeName[id] = name
+	m.DomainOfQualityType[id] = domain
+
+	// Return the new type ID
+	return id
+}
+
+// Adding an involvement type
+func (m *TCDMModel) AddInvolvementType(name string, base string) string {
+	// Settings things up for a new involvement type
+	id := m.NewElementID()
+	m.InvolvementTypes[id] = true
+	m.TypeName[id] = name
+	m.BaseTypeOfInvolvementType[id] = base
+
+	// Return the new type ID
+	return id
+}
+
+// Adding a relation type
+func (m *TCDMModel) AddRelationType(name string, involvementTypes ...string) string {
+	// Settings things up for a new relation type
+	id := m.NewElementID()
+	m.RelationTypes[id] = true
+	m.TypeName[id] = name
+
+	// Setting up the involvement types of this relation type
+	m.InvolvementTypesOfRelationType[id] = map[string]bool{}
+	for _, involvementType := range involvementTypes {
+		m.RelationTypeOfInvolvementType[involvementType] = id
+		m.InvolvementTypesOfRelationType[id][involvementType] = true
+	}
+
+	// Setting up the alternative readings of this relation type
+	m.AlternativeReadingsOfRelationType[id] = map[string]bool{}
+
+	// Return the new type ID
+	return id
+}
+
+// Adding a relation type reading
+func (m *TCDMModel) AddRelationTypeReading(relationType string, stringsAndInvolvementTypes ...string) string {
+	// Creating the relation type reading
+	reading := TRelationReading{}
+
+	// Splitting the strings and involvement types
+	// These should be given in an alternating manner
+	// For an n-ary relation type, we should have:
+	//    s_1, ..., s_{n+1} strings
+	// that are part of the reading, and
+	//    i_1, ..., i_n strings
+	// referring to involvement types, which should be ordered as:
+	//    s_1, i_1, s_2, i_2, ..., i_n, s_{n+1}
+	//
+	// Note: Technically, this function should require a check to see if all InvolvementTypesss of the relation
+	// have been used ... and used only once
+	// But ... as this is only "Hello World" for now, so we won't do so yet.
+	//
+	isReadingString := true
+	for _, element := range stringsAndInvolvementTypes {
+		if isReadingString {
+			reading.ReadingElements = append(reading.ReadingElements, element)
+		} else {
+			reading.InvolvementTypes = append(reading.InvolvementTypes, element)
+		}
+		isReadingString = !isReadingString
+	}
+
+	// Adding the reading to the model
+	readingID := m.NewElementID()
+	m.AlternativeReadingsOfRelationType[relationType][readingID] = true
+	m.ReadingDefinition[readingID] = reading
+
+	// If this is the first reading for the relation type, then we will make it to be the primary reading
+	if m.PrimaryReadingOfRelationType[relationType] == "" {
+		m.PrimaryReadingOfRelationType[relationType] = readingID
+	}
+
+	// Return this reaading's Reading ID
+	return readingID
+}
+
+/*
+ * Removing elements from CDM models
+ */
+
+// Removing the relation types in which a given type is involved
+func (m *TCDMModel) removeRelationTypesInvolving(typeID string) {
+	for involvementType, baseType := range m.BaseTypeOfInvolvementType {
+		if baseType == typeID {
+			m.RemoveRelationType(m.RelationTypeOfInvolvementType[involvementType])
+		}
+	}
+}
+
+// Removing a concrete individual type, as well as the relation types in which it is involved
+func (m *TCDMModel) RemoveConcreteIndividualType(id string) bool {
+	// Check that it is a concrete individual type
+	if !m.ConcreteIndividualTypes[id] {
+		return false
+	}
+
+	// Remove the concrete individual type, including its instances
+	delete(m.ConcreteIndividualTypes, id)
+	delete(m.TypeName, id)
+	for instance, instanceType := range m.TypeOfInstance {
+		if instanceType == id {
+			m.RemoveInstance(instance)
+		}
+	}
+
+	// Remove the subtyping it is part of
+	delete(m.SupertypesOfConcreteIndividualType, id)
+	for subtype := range m.SupertypesOfConcreteIndividualType {
+		m.RemoveSubtyping(subtype, id)
+	}
+
+	// Remove the relation types in which it is involved
+	m.removeRelationTypesInvolving(id)
+
+	return true
+}
+
+// Removing a quality type, as well as the relation types in which it is involved
+func (m *TCDMModel) RemoveQualityType(id string) bool {
+	// Check that it is a quality type
+	if !m.QualityTypes[id] {
+		return false
+	}
+
+	// Remove the quality type
+	delete(m.QualityTypes, id)
+	delete(m.TypeName, id)
+	delete(m.DomainOfQualityType, id)
+	delete(m.ValuesOfQualityType, id)
+
+	// Remove the relation types in which it is involved
+	m.removeRelationTypesInvolving(id)
+
+	return true
+}
+
+// Removing a relation type, including its involvement types and readings, as well as the relation types in which it is involved
+func (m *TCDMModel) RemoveRelationType(id string) bool {
+	// Check that it is a relation type
+	if !m.RelationTypes[id] {
+		return false
+	}
+
+	// Remove the relation type
+	delete(m.RelationTypes, id)
+	delete(m.TypeName, id)
+
+	// Remove its involvement types, including the constraints on them
+	for involvementType := range m.InvolvementTypesOfRelationType[id] {
+		delete(m.InvolvementTypes, involvementType)
+		delete(m.TypeName, involvementType)
+		delete(m.BaseTypeOfInvolvementType, involvementType)
+		delete(m.RelationTypeOfInvolvementType, involvementType)
+		m.removeConstraintsOn(involvementType)
+	}
+	delete(m.InvolvementTypesOfRelationType, id)
+
+	// Remove its facts
+	for fact, relationType := range m.RelationTypeOfFact {
+		if relationType == id {
+			m.RemoveFact(fact)
+		}
+	}
+
+	// Remove its readings
+	for reading := range m.AlternativeReadingsOfRelationType[id] {
+		delete(m.ReadingDefinition, reading)
+	}
+	delete(m.AlternativeReadingsOfRelationType, id)
+	delete(m.PrimaryReadingOfRelationType, id)
+
+	// Remove the relation types in which it is involved
+	m.removeRelationTypesInvolving(id)
+
+	return true
+}
+
+// Removing a relation type reading, where another reading becomes the primary reading if needed
+func (m *TCDMModel) RemoveReading(readingID string) bool {
+	// Find the relation type of the reading
+	relationType, found := m.RelationTypeOfReading(readingID)
+	if !found {
+		return false
+	}
+
+	// Remove the reading
+	delete(m.AlternativeReadingsOfRelationType[relationType], readingID)
+	delete(m.ReadingDefinition, readingID)
+
+	// Select another primary reading, if needed
+	if m.PrimaryReadingOfRelationType[relationType] == readingID {
+		delete(m.PrimaryReadingOfRelationType, relationType)
+		if readings := sortedKeys(m.AlternativeReadingsOfRelationType[relationType]); len(readings) > 0 {
+			m.PrimaryReadingOfRelationType[relationType] = readings[0]
+		}
+	}
+
+	return true
+}
+
+/*
+ * Modifying elements of CDM models
+ */
+
+// Finding the relation type of a reading
+func (m *TCDMModel) RelationTypeOfReading(readingID string) (string, bool) {
+	for relationType, readings := range m.AlternativeReadingsOfRelationType {
+		if readings[readingID] {
+			return relationType, true
+		}
+	}
+
+	return "", false
+}
+
+// Renaming a type (of any kind)
+func (m *TCDMModel) RenameType(id, name string) bool {
+	// Check that the type exists
+	if _, exists := m.TypeName[id]; !exists {
+		return false
+	}
+
+	// Rename it
+	m.TypeName[id] = name
+
+	return true
+}
+
+// Changing the domain of a quality type
+func (m *TCDMModel) ChangeQualityTypeDomain(id, domain string) bool {
+	// Check that it is a quality type
+	if !m.QualityTypes[id] {
+		return false
+	}
+
+	// Change the domain
+	m.DomainOfQualityType[id] = domain
+
+	return true
+}
+
+// Setting the primary reading of a relation type, which should be one of its readings
+func (m *TCDMModel) SetPrimaryReading(relationType, readingID string) bool {
+	// Check that it is a reading of the relation type
+	if !m.AlternativeReadingsOfRelationType[relationType][readingID] {
+		return false
+	}
+
+	// Set the primary reading
+	m.PrimaryReadingOfRelationType[relationType] = readingID
+
+	return true
+}
+
+/*
+ * Subtyping of concrete individual types
+ */
+
+// Adding a subtyping between two concrete individual types.
+// The subtyping is not added when this would make a type a subtype of itself.
+func (m *TCDMModel) AddSubtyping(subtype, supertype string) bool {
+	// Check that both are concrete individual types
+	if !m.ConcreteIndividualTypes[subtype] || !m.ConcreteIndividualTypes[supertype] {
+		return false
+	}
+
+	// Check that the subtyping remains acyclic
+	if m.IsSubtypeOf(supertype, subtype) {
+		return false
+	}
+
+	// Add the subtyping
+	if m.SupertypesOfConcreteIndividualType[subtype] == nil {
+		m.SupertypesOfConcreteIndividualType[subtype] = map[string]bool{}
+	}
+	m.SupertypesOfConcreteIndividualType[subtype][supertype] = true
+
+	return true
+}
+
+// Removing a subtyping between two concrete individual types
+func (m *TCDMModel) RemoveSubtyping(subtype, supertype string) bool {
+	// Check that the subtyping exists
+	if !m.SupertypesOfConcreteIndividualType[subtype][supertype] {
+		return false
+	}
+
+	// Remove the subtyping
+	delete(m.SupertypesOfConcreteIndividualType[subtype], supertype)
+	if len(m.SupertypesOfConcreteIndividualType[subtype]) == 0 {
+		delete(m.SupertypesOfConcreteIndividualType, subtype)
+	}
+
+	return true
+}
+
+// Getting all (direct and indirect) supertypes of a type
+func (m *TCDMModel) SupertypesOf(typeID string) map[string]bool {
+	supertypes := map[string]bool{}
+
+	// Follow the subtypings, where the visited types guard against cycles in (invalid) models received
+	toVisit := []string{typeID}
+	for len(toVisit) > 0 {
+		current := toVisit[0]
+		toVisit = toVisit[1:]
+		for supertype := range m.SupertypesOfConcreteIndividualType[current] {
+			if !supertypes[supertype] {
+				supertypes[supertype] = true
+				toVisit = append(toVisit, supertype)
+			}
+		}
+	}
+
+	return supertypes
+}
+
+// Getting all (direct and indirect) subtypes of a type
+func (m *TCDMModel) SubtypesOf(typeID string) map[string]bool {
+	subtypes := map[string]bool{}
+	for subtype := range m.SupertypesOfConcreteIndividualType {
+		if m.SupertypesOf(subtype)[typeID] {
+			subtypes[subtype] = true
+		}
+	}
+
+	return subtypes
+}
+
+// Checking whether a type is a (direct or indirect) subtype of another type, or the type itself
+func (m *TCDMModel) IsSubtypeOf(subtype, supertype string) bool {
+	return subtype == supertype || m.SupertypesOf(subtype)[supertype]
+}
+
+// Checking whether (the instances of) a type can play an involvement type, either directly or inherited from a supertype
+func (m *TCDMModel) CanPlayInvolvementType(typeID, involvementType string) bool {
+	return m.InvolvementTypes[involvementType] && m.IsSubtypeOf(typeID, m.BaseTypeOfInvolvementType[involvementType])
+}
+
+// Getting the involvement types a type can play, including those inherited from its supertypes
+func (m *TCDMModel) InvolvementTypesPlayableBy(typeID string) map[string]bool {
+	involvementTypes := map[string]bool{}
+	for involvementType := range m.InvolvementTypes {
+		if m.CanPlayInvolvementType(typeID, involvementType) {
+			involvementTypes[involvementType] = true
+		}
+	}
+
+	return involvementTypes
+}
+
+/*
+ * Creating & cleaning CDM models
+ */
+
+// Cleaning a CDM model
+func (m *TCDMModel) Clean() {
+	// Resetting all fields
+	m.ModelName = ""
+	m.ConcreteIndividualTypes = map[string]bool{}
+	m.SupertypesOfConcreteIndividualType = map[string]map[string]bool{}
+	m.QualityTypes = map[string]bool{}
+	m.RelationTypes = map[string]bool{}
+	m.InvolvementTypes = map[string]bool{}
+	m.TypeName = map[string]string{}
+	m.DomainOfQualityType = map[string]string{}
+	m.BaseTypeOfInvolvementType = map[string]string{}
+	m.RelationTypeOfInvolvementType = map[string]string{}
+	m.InvolvementTypesOfRelationType = map[string]map[string]bool{}
+	m.AlternativeReadingsOfRelationType = map[string]map[string]bool{}
+	m.PrimaryReadingOfRelationType = map[string]string{}
+	m.ReadingDefinition = map[string]TRelationReading{}
+	m.UniquenessConstraints = map[string]bool{}
+	m.InvolvementTypesOfUniquenessConstraint = map[string]map[string]bool{}
+	m.MandatoryInvolvementTypes = map[string]bool{}
+	m.FrequencyOfInvolvementType = map[string]TFrequency{}
+	m.ValuesOfQualityType = map[string][]string{}
+	m.Instances = map[string]bool{}
+	m.TypeOfInstance = map[string]string{}
+	m.LabelOfInstance = map[string]string{}
+	m.Facts = map[string]bool{}
+	m.RelationTypeOfFact = map[string]string{}
+	m.FillersOfFact = map[string]map[string]string{}
+}
+
+// Creating a new CDM model
+func CreateCDMModel(reporter *generics.TReporter) TCDMModel {
+	// Create an empty CDM model
+	CDMModel := TCDMModel{}
+	CDMModel.Clean()
+
+	// Setting up the reporter
+	CDMModel.reporter = reporter
+
+	// Return the created model
+	return CDMModel
+}
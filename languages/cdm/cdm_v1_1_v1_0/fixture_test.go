@@ -0,0 +1,137 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Test model
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+type (
+	// The IDs of the elements of the test model
+	tTestModelIDs struct {
+		person, employee, company, name, worker, employer, worksFor, reading string
+
+		alice, acme, aliceWorksForAcme string // The population, for the populated test model
+	}
+
+	// A test case modifying the test model
+	tKindsCase = cdmtest.TKindsCase[TCDMModel, tTestModelIDs]
+)
+
+// Creating a small, valid, test model
+func createTestModel() (TCDMModel, tTestModelIDs) {
+	ids := tTestModelIDs{}
+
+	model := CreateCDMModel(nil)
+	model.SetModelName("Employment")
+	ids.person = model.AddConcreteIndividualType("Person")
+	ids.employee = model.AddConcreteIndividualType("Employee")
+	ids.company = model.AddConcreteIndividualType("Company")
+	ids.name = model.AddQualityType("Name", "string")
+	model.AddSubtyping(ids.employee, ids.person)
+	ids.worker = model.AddInvolvementType("worker", ids.employee)
+	ids.employer = model.AddInvolvementType("employer", ids.company)
+	ids.worksFor = model.AddRelationType("Works for", ids.worker, ids.employer)
+	ids.reading = model.AddRelationTypeReading(ids.worksFor, "", ids.worker, "works for", ids.employer, "")
+
+	return model, ids
+}
+
+// Creating the test model, populated with an employee working for a company
+func createPopulatedTestModel() (TCDMModel, tTestModelIDs) {
+	model, ids := createTestModel()
+	ids.alice = model.AddInstance(ids.employee, "Alice")
+	ids.acme = model.AddInstance(ids.company, "Acme")
+	ids.aliceWorksForAcme = model.AddFact(ids.worksFor, map[string]string{ids.worker: ids.alice, ids.employer: ids.acme})
+
+	return model, ids
+}
+
+// Adding a relation type with one involvement type, whose base type is the relation type itself
+func addSelfNestingRelationType(m *TCDMModel) (string, string) {
+	amended := m.AddInvolvementType("amended", "")
+	amends := m.AddRelationType("Amends", amended)
+	m.BaseTypeOfInvolvementType[amended] = amends
+	m.AddRelationTypeReading(amends, "", amended, "is amended")
+
+	return amends, amended
+}
+
+// Adding a fact without checking its fillers, as may be the case in (invalid) models received
+func addUncheckedFact(m *TCDMModel, id, relationType string, fillers map[string]string) {
+	m.Facts[id] = true
+	m.RelationTypeOfFact[id] = relationType
+	m.FillersOfFact[id] = fillers
+}
+
+// Copying a model, so that the copy can be changed independently
+func copyTestModel(t *testing.T, model TCDMModel) TCDMModel {
+	t.Helper()
+
+	modelJSON, ok := model.GetModelAsJSON()
+	if !ok {
+		t.Fatalf("the model could not be converted to JSON")
+	}
+
+	modelCopy := CreateCDMModel(nil)
+	if !modelCopy.SetModelFromJSON(modelJSON) {
+		t.Fatalf("the model could not be converted from JSON")
+	}
+
+	return modelCopy
+}
+
+// Getting the kinds of the findings of validating a model
+func findingKinds(model TCDMModel) []string {
+	return cdmtest.Kinds(model.Validate(), func(finding TCDMFinding) string { return finding.Kind })
+}
+
+// Getting the kinds of the changes resulting from modifying a copy of a model
+func changeKinds(t *testing.T, model TCDMModel, ids tTestModelIDs, modify func(*TCDMModel, tTestModelIDs)) []string {
+	newModel := copyTestModel(t, model)
+	modify(&newModel, ids)
+
+	return cdmtest.Kinds(CompareModels(model, newModel), func(change TCDMChange) string { return change.Kind })
+}
+
+// Getting the kinds of the findings of validating the test model, after modifying it
+func findingKindsAfter(t *testing.T, modify func(*TCDMModel, tTestModelIDs)) []string {
+	model, ids := createTestModel()
+	modify(&model, ids)
+
+	return findingKinds(model)
+}
+
+// Getting the kinds of the findings of validating the populated test model, after modifying it
+func populatedFindingKindsAfter(t *testing.T, modify func(*TCDMModel, tTestModelIDs)) []string {
+	model, ids := createPopulatedTestModel()
+	modify(&model, ids)
+
+	return findingKinds(model)
+}
+
+// Getting the kinds of the changes resulting from modifying the test model
+func changeKindsAfter(t *testing.T, modify func(*TCDMModel, tTestModelIDs)) []string {
+	model, ids := createTestModel()
+
+	return changeKinds(t, model, ids, modify)
+}
+
+// Getting the kinds of the changes resulting from modifying the populated test model
+func populatedChangeKindsAfter(t *testing.T, modify func(*TCDMModel, tTestModelIDs)) []string {
+	model, ids := createPopulatedTestModel()
+
+	return changeKinds(t, model, ids, modify)
+}
@@ -0,0 +1,55 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Language
+ *
+ * This component registers the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * with the registry of modelling languages.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+	"github.com/erikproper/big-modelling-bus.go.v1/languages"
+)
+
+/*
+ * Registering the language
+ */
+
+// Creating an empty CDM model, for the language registry
+func newCDMModel(reporter *generics.TReporter) any {
+	model := CreateCDMModel(reporter)
+
+	return &model
+}
+
+func init() {
+	// Define the language
+	language := languages.TLanguage{}
+	language.JSONVersion = ModelJSONVersion
+	language.Name = "Conceptual Domain Modelling, Version 1.1"
+	language.NewModel = newCDMModel
+	language.Schema = []byte(ModelJSONSchema)
+	language.Validator = ValidateModelJSON
+	language.Converters = map[string]connect.TJSONMigration{
+		PreviousModelJSONVersion: migrateToPreviousVersion,
+	}
+
+	// Register it
+	if err := languages.Register(language); err != nil {
+		panic(err)
+	}
+
+	// Register the migration from the previous version, which is converted to this version
+	connect.RegisterJSONMigration(PreviousModelJSONVersion, ModelJSONVersion, migrateFromPreviousVersion)
+}
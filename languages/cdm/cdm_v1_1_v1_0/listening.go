@@ -0,0 +1,201 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Definition
+ *
+ * This component provides the functionality fto listen for updates of
+ * models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1,
+ * on the BIG Modelling Bus.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Definition of the CDM model listener
+ */
+
+type (
+	TCDMModelListener struct {
+		ModelListener connect.TListener[TCDMModel] // The typed listener used to listen for the models, and to report the findings of validating them
+
+		CurrentModel    TCDMModel
+		UpdatedModel    TCDMModel
+		ConsideredModel TCDMModel
+
+		Changes TCDMChangeSet // The changes of the models resulting from the latest posting received
+	}
+)
+
+/*
+ * Getting model versions from the modelling bus
+ */
+
+// Updating all models from the modelling bus
+func (l *TCDMModelListener) UpdateModelsFromBus() {
+	// Keep the previous versions of the models, to determine the changes.
+	// The models obtained from the modelling bus have new maps, so the previous versions are not affected.
+	previousCurrentModel := l.CurrentModel
+	previousUpdatedModel := l.UpdatedModel
+	previousConsideredModel := l.ConsideredModel
+
+	l.CurrentModel.AdoptModel(l.ModelListener.Current())
+	l.UpdatedModel.AdoptModel(l.ModelListener.Updated())
+	l.ConsideredModel.AdoptModel(l.ModelListener.Considered())
+
+	// Determine the changes
+	l.Changes.Current = CompareModels(previousCurrentModel, l.CurrentModel)
+	l.Changes.Updated = CompareModels(previousUpdatedModel, l.UpdatedModel)
+	l.Changes.Considered = CompareModels(previousConsideredModel, l.ConsideredModel)
+}
+
+// Listening for model state postings on the modelling bus
+func (l *TCDMModelListener) ListenForModelStatePostings(agentID, modelID string, handler func()) {
+	// Setting up listening for model state postings
+	l.ModelListener.ListenForStatePostings(agentID, modelID, func(TCDMModel) {
+		l.UpdateModelsFromBus()
+		handler()
+	})
+}
+
+// Listening for model update postings on the modelling bus
+func (l *TCDMModelListener) ListenForModelUpdatePostings(agentID, modelID string, handler func()) {
+	// Setting up listening for model update postings
+	l.ModelListener.ListenForUpdatePostings(agentID, modelID, func(TCDMModel) {
+		l.UpdateModelsFromBus()
+		handler()
+	})
+}
+
+// Listening for model considering postings on the modelling bus
+func (l *TCDMModelListener) ListenForModelConsideringPostings(agentID, modelID string, handler func()) {
+	// Setting up listening for model considering postings
+	l.ModelListener.ListenForConsideringPostings(agentID, modelID, func(TCDMModel) {
+		l.UpdateModelsFromBus()
+		handler()
+	})
+}
+
+// Listening for changes of the models on the modelling bus, resulting from state, update and considering postings.
+// The handler is only called when the models actually changed.
+func (l *TCDMModelListener) ListenForModelChanges(agentID, modelID string, handler func(TCDMChangeSet)) {
+	changesHandler := func() {
+		if !l.Changes.IsEmpty() {
+			handler(l.Changes)
+		}
+	}
+
+	l.ListenForModelStatePostings(agentID, modelID, changesHandler)
+	l.ListenForModelUpdatePostings(agentID, modelID, changesHandler)
+	l.ListenForModelConsideringPostings(agentID, modelID, changesHandler)
+}
+
+/*
+ *  Aggregate data across the model versions
+ */
+
+func (l *TCDMModelListener) UniteIDSets(mp func(TCDMModel) map[string]bool) map[string]bool {
+	// Start with an empty result set
+	result := map[string]bool{}
+
+	// Collecting IDs from the current model
+	for e, c := range mp(l.CurrentModel) {
+		if c {
+			result[e] = true
+		}
+	}
+
+	// Collecting IDs from the updated model
+	for e, c := range mp(l.UpdatedModel) {
+		if c {
+			result[e] = true
+		}
+	}
+
+	// Collecting IDs from the considered model
+	for e, c := range mp(l.ConsideredModel) {
+		if c {
+			result[e] = true
+		}
+	}
+
+	// Return the collected result
+	return result
+}
+
+func (l *TCDMModelListener) QualityTypes() map[string]bool {
+	// Unite the quality types across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.QualityTypes
+	})
+}
+
+func (l *TCDMModelListener) ConcreteIndividualTypes() map[string]bool {
+	// Unite the concrete individual types across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.ConcreteIndividualTypes
+	})
+}
+
+func (l *TCDMModelListener) RelationTypes() map[string]bool {
+	// Unite the relation types across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.RelationTypes
+	})
+}
+
+func (l *TCDMModelListener) InvolvementTypesOfRelationType(relationType string) map[string]bool {
+	// Unite the involvement types of the given relation type across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.InvolvementTypesOfRelationType[relationType]
+	})
+}
+
+func (l *TCDMModelListener) AlternativeReadingsOfRelationType(relationType string) map[string]bool {
+	// Unite the alternative readings of the given relation type across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.AlternativeReadingsOfRelationType[relationType]
+	})
+}
+
+func (l *TCDMModelListener) Instances() map[string]bool {
+	// Unite the instances across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.Instances
+	})
+}
+
+func (l *TCDMModelListener) Facts() map[string]bool {
+	// Unite the facts across the models
+	return l.UniteIDSets(func(m TCDMModel) map[string]bool {
+		return m.Facts
+	})
+}
+
+/*
+ *  Creating and updating the model listener
+ */
+
+// Creating a CDM model listener, which uses a given ModellingBusConnector to listen for models and their updates
+func CreateCDMListener(ModellingBusConnector connect.TModellingBusConnector, reporter *generics.TReporter) TCDMModelListener {
+	// Setting up a new CDM model listener
+	cdmModelListener := TCDMModelListener{}
+	cdmModelListener.ModelListener = connect.CreateListener[TCDMModel](ModellingBusConnector, ModelJSONVersion)
+	cdmModelListener.CurrentModel = CreateCDMModel(reporter)
+	cdmModelListener.UpdatedModel = CreateCDMModel(reporter)
+	cdmModelListener.ConsideredModel = CreateCDMModel(reporter)
+
+	// Return the created CDM model listener
+	return cdmModelListener
+}
@@ -0,0 +1,81 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Migration
+ *
+ * This component provides the migrations between models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * and models expressed in the previous version of the language.
+ * Version 1.1 extends version 1.0, so migrating from version 1.0 does not lose any information, while
+ * migrating to version 1.0 drops the parts of models not expressible in version 1.0, such as subtyping,
+ * constraints and populations.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"encoding/json"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdm_v1_0_v1_0"
+)
+
+/*
+ * Defining key constants
+ */
+
+const (
+	PreviousModelJSONVersion = cdm_v1_0_v1_0.ModelJSONVersion // The JSON version identifier of the previous version of CDM
+)
+
+var (
+	// The JSON fields introduced in this version of CDM, which are dropped when migrating to the previous version
+	introducedJSONFields = []string{
+		"supertypes of concrete individual types",
+		"uniqueness constraints",
+		"involvement types of uniqueness constraints",
+		"mandatory involvement types",
+		"frequencies of involvement types",
+		"values of quality types",
+		"instances",
+		"types of instances",
+		"labels of instances",
+		"facts",
+		"relation types of facts",
+		"fillers of facts",
+	}
+)
+
+/*
+ * Migrating models
+ */
+
+// Migrating a model from the previous version of CDM, where the missing fields are added as empty ones
+func migrateFromPreviousVersion(modelJSON []byte) ([]byte, error) {
+	model := TCDMModel{}
+	model.Clean()
+	if err := json.Unmarshal(modelJSON, &model); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(model)
+}
+
+// Migrating a model to the previous version of CDM, dropping the fields introduced in this version
+func migrateToPreviousVersion(modelJSON []byte) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(modelJSON, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, field := range introducedJSONFields {
+		delete(fields, field)
+	}
+
+	return json.Marshal(fields)
+}
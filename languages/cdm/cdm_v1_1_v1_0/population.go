@@ -0,0 +1,182 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Population
+ *
+ * This component provides the population of models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * The population consists of instances of concrete individual types, and facts of relation types. The
+ * involvement types of a fact are filled by instances (for concrete individual types), values (for quality
+ * types), or other facts (for relation types). Facts cannot nest themselves, directly or indirectly, as this
+ * would make them impossible to verbalise.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+/*
+ * Removing facts
+ */
+
+// Removing the facts in which an instance or fact is involved as filler
+func (m *TCDMModel) removeFactsInvolving(filler string) {
+	for fact, fillers := range m.FillersOfFact {
+		for involvementType, factFiller := range fillers {
+			if factFiller == filler && !m.QualityTypes[m.BaseTypeOfInvolvementType[involvementType]] {
+				m.RemoveFact(fact)
+				break
+			}
+		}
+	}
+}
+
+/*
+ * Nesting facts
+ */
+
+// Checking whether a filler of an involvement type is a nested fact, i.e. the base type is a relation type
+func (m *TCDMModel) isNestedFact(involvementType, filler string) bool {
+	return m.RelationTypes[m.BaseTypeOfInvolvementType[involvementType]] && m.Facts[filler]
+}
+
+// Getting the facts nested in a fact, directly or indirectly, as fillers
+func (m *TCDMModel) nestedFactsOf(fact string) map[string]bool {
+	nestedFacts := map[string]bool{}
+
+	// Follow the fillers, where the visited facts guard against cycles in (invalid) models received
+	toVisit := []string{fact}
+	for len(toVisit) > 0 {
+		current := toVisit[0]
+		toVisit = toVisit[1:]
+		for involvementType, filler := range m.FillersOfFact[current] {
+			if m.isNestedFact(involvementType, filler) && !nestedFacts[filler] {
+				nestedFacts[filler] = true
+				toVisit = append(toVisit, filler)
+			}
+		}
+	}
+
+	return nestedFacts
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Adding an instance of a concrete individual type, with a label used in verbalisations.
+// Returns the ID of the instance, or an empty string when the type is not a concrete individual type.
+func (m *TCDMModel) AddInstance(typeID, label string) string {
+	// Check that it is a concrete individual type
+	if !m.ConcreteIndividualTypes[typeID] {
+		return ""
+	}
+
+	// Settings things up for a new instance
+	id := m.NewElementID()
+	m.Instances[id] = true
+	m.TypeOfInstance[id] = typeID
+	m.LabelOfInstance[id] = label
+
+	// Return the new instance ID
+	return id
+}
+
+// Removing an instance, as well as the facts in which it is involved
+func (m *TCDMModel) RemoveInstance(id string) bool {
+	// Check that it is an instance
+	if !m.Instances[id] {
+		return false
+	}
+
+	// Remove the instance
+	delete(m.Instances, id)
+	delete(m.TypeOfInstance, id)
+	delete(m.LabelOfInstance, id)
+
+	// Remove the facts in which it is involved
+	m.removeFactsInvolving(id)
+
+	return true
+}
+
+// Adding a fact of a relation type, where the fillers are given by involvement type.
+// All involvement types of the relation type should be filled, where the fillers of involvement types with a
+// relation type as base type should be existing facts that do not nest themselves, so no cyclic facts arise.
+// Returns the ID of the fact, or an empty string when the fillers do not match the involvement types.
+func (m *TCDMModel) AddFact(relationType string, fillers map[string]string) string {
+	// Check that the fillers match the involvement types of the relation type
+	if !m.RelationTypes[relationType] || len(fillers) != len(m.InvolvementTypesOfRelationType[relationType]) {
+		return ""
+	}
+	for involvementType, filler := range fillers {
+		if !m.InvolvementTypesOfRelationType[relationType][involvementType] {
+			return ""
+		}
+
+		// Nested facts should exist, and not nest themselves
+		if m.RelationTypes[m.BaseTypeOfInvolvementType[involvementType]] && (!m.isNestedFact(involvementType, filler) || m.nestedFactsOf(filler)[filler]) {
+			return ""
+		}
+	}
+
+	// Settings things up for a new fact
+	id := m.NewElementID()
+	m.Facts[id] = true
+	m.RelationTypeOfFact[id] = relationType
+	m.FillersOfFact[id] = map[string]string{}
+	for involvementType, filler := range fillers {
+		m.FillersOfFact[id][involvementType] = filler
+	}
+
+	// Return the new fact ID
+	return id
+}
+
+// Removing a fact, as well as the facts in which it is involved
+func (m *TCDMModel) RemoveFact(id string) bool {
+	// Check that it is a fact
+	if !m.Facts[id] {
+		return false
+	}
+
+	// Remove the fact
+	delete(m.Facts, id)
+	delete(m.RelationTypeOfFact, id)
+	delete(m.FillersOfFact, id)
+
+	// Remove the facts in which it is involved
+	m.removeFactsInvolving(id)
+
+	return true
+}
+
+// Getting the instances of a concrete individual type, including the instances of its subtypes
+func (m *TCDMModel) InstancesOf(typeID string) map[string]bool {
+	instances := map[string]bool{}
+	for instance, instanceType := range m.TypeOfInstance {
+		if m.IsSubtypeOf(instanceType, typeID) {
+			instances[instance] = true
+		}
+	}
+
+	return instances
+}
+
+// Getting the facts of a relation type
+func (m *TCDMModel) FactsOf(relationType string) map[string]bool {
+	facts := map[string]bool{}
+	for fact, factRelationType := range m.RelationTypeOfFact {
+		if factRelationType == relationType {
+			facts[fact] = true
+		}
+	}
+
+	return facts
+}
@@ -0,0 +1,70 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Population (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+func TestAddFact(t *testing.T) {
+	model, ids := createPopulatedTestModel()
+	employment := model.AddInvolvementType("employment", ids.worksFor)
+	isContracted := model.AddRelationType("Is contracted", employment)
+	model.AddRelationTypeReading(isContracted, "", employment, "is contracted")
+
+	cdmtest.RunValidityCases(t, []cdmtest.TValidityCase{
+		{Name: "missing filler", Try: func() bool {
+			return model.AddFact(ids.worksFor, map[string]string{ids.worker: ids.alice}) != ""
+		}, Valid: false},
+		{Name: "unknown involvement type", Try: func() bool {
+			return model.AddFact(ids.worksFor, map[string]string{ids.worker: ids.alice, employment: ids.acme}) != ""
+		}, Valid: false},
+		{Name: "nested fact", Try: func() bool {
+			return model.AddFact(isContracted, map[string]string{employment: ids.aliceWorksForAcme}) != ""
+		}, Valid: true},
+		{Name: "nested instance", Try: func() bool {
+			return model.AddFact(isContracted, map[string]string{employment: ids.alice}) != ""
+		}, Valid: false},
+		{Name: "nested unknown fact", Try: func() bool {
+			return model.AddFact(isContracted, map[string]string{employment: "unknown"}) != ""
+		}, Valid: false},
+	})
+
+	cdmtest.AssertKinds(t, findingKinds(model), []string{})
+}
+
+func TestCyclicFacts(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "direct", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			amends, amended := addSelfNestingRelationType(m)
+			addUncheckedFact(m, "f1", amends, map[string]string{amended: "f1"})
+		}, Kinds: []string{FindingCyclicFact}},
+		{Name: "indirect", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			amends, amended := addSelfNestingRelationType(m)
+			addUncheckedFact(m, "f1", amends, map[string]string{amended: "f2"})
+			addUncheckedFact(m, "f2", amends, map[string]string{amended: "f1"})
+		}, Kinds: []string{FindingCyclicFact, FindingCyclicFact}},
+	}, findingKindsAfter)
+}
+
+func TestAddFactNestingCyclicFact(t *testing.T) {
+	model, _ := createTestModel()
+	amends, amended := addSelfNestingRelationType(&model)
+	addUncheckedFact(&model, "f1", amends, map[string]string{amended: "f1"})
+
+	if model.AddFact(amends, map[string]string{amended: "f1"}) != "" {
+		t.Errorf("a fact nesting a cyclic fact should be rejected")
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Definition
+ *
+ * This component provides the functionality for models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1,
+ * to be posted on he BIG Modelling Bus.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+)
+
+/*
+ * Definition of the CDM model poster
+ */
+
+type (
+	// The poster of CDM models, which validates models before posting them when asked to (see UseValidation)
+	TCDMModelPoster = connect.TPoster[TCDMModel]
+)
+
+/*
+ * Adopting models from the modelling bus
+ */
+
+// Setting the model to a model obtained from the modelling bus, while keeping the properties not posted on the bus
+func (m *TCDMModel) AdoptModel(model TCDMModel) {
+	model.reporter = m.reporter
+	model.ModelListener = m.ModelListener
+
+	*m = model
+}
+
+/*
+ *  Creating the model poster
+ */
+
+// Creating a CDM model poster, which uses a given ModellingBusConnector to post the model
+func CreateCDMPoster(ModellingBusConnector connect.TModellingBusConnector, modelID string) TCDMModelPoster {
+	return connect.CreatePoster[TCDMModel](ModellingBusConnector, ModelJSONVersion, modelID)
+}
@@ -0,0 +1,105 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Schema
+ *
+ * This component provides the JSON Schema of the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * The schema is registered, as part of the language, for the JSON version of CDM models, so that artefact
+ * connectors validate the CDM models they post and receive.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+/*
+ * Defining the JSON schema
+ */
+
+const (
+	// The JSON Schema for CDM v1.1-v1.0 models
+	ModelJSONSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "CDM model (cdm-v1.1-v1.0)",
+	"type": "object",
+	"required": ["model name"],
+	"properties": {
+		"model name": { "type": "string" },
+		"type names": { "$ref": "#/$defs/names" },
+		"concrete individual types": { "$ref": "#/$defs/set" },
+		"supertypes of concrete individual types": { "$ref": "#/$defs/sets" },
+		"quality types": { "$ref": "#/$defs/set" },
+		"domains of quality types": { "$ref": "#/$defs/names" },
+		"involvement types": { "$ref": "#/$defs/set" },
+		"base types of involvement types": { "$ref": "#/$defs/names" },
+		"relation types of involvement types": { "$ref": "#/$defs/names" },
+		"relation types": { "$ref": "#/$defs/set" },
+		"involvement types of relation types": { "$ref": "#/$defs/sets" },
+		"alternative readings of relation types": { "$ref": "#/$defs/sets" },
+		"primary readings of relation types": { "$ref": "#/$defs/names" },
+		"reading definition": {
+			"type": "object",
+			"additionalProperties": { "$ref": "#/$defs/reading" }
+		},
+		"uniqueness constraints": { "$ref": "#/$defs/set" },
+		"involvement types of uniqueness constraints": { "$ref": "#/$defs/sets" },
+		"mandatory involvement types": { "$ref": "#/$defs/set" },
+		"frequencies of involvement types": {
+			"type": "object",
+			"additionalProperties": { "$ref": "#/$defs/frequency" }
+		},
+		"values of quality types": {
+			"type": "object",
+			"additionalProperties": { "$ref": "#/$defs/strings" }
+		},
+		"instances": { "$ref": "#/$defs/set" },
+		"types of instances": { "$ref": "#/$defs/names" },
+		"labels of instances": { "$ref": "#/$defs/names" },
+		"facts": { "$ref": "#/$defs/set" },
+		"relation types of facts": { "$ref": "#/$defs/names" },
+		"fillers of facts": {
+			"type": "object",
+			"additionalProperties": { "$ref": "#/$defs/names" }
+		}
+	},
+	"$defs": {
+		"set": {
+			"type": "object",
+			"additionalProperties": { "type": "boolean" }
+		},
+		"sets": {
+			"type": "object",
+			"additionalProperties": { "$ref": "#/$defs/set" }
+		},
+		"names": {
+			"type": "object",
+			"additionalProperties": { "type": "string" }
+		},
+		"strings": {
+			"type": ["array", "null"],
+			"items": { "type": "string" }
+		},
+		"reading": {
+			"type": "object",
+			"required": ["involvement types", "reading elements"],
+			"properties": {
+				"involvement types": { "$ref": "#/$defs/strings" },
+				"reading elements": { "$ref": "#/$defs/strings" }
+			}
+		},
+		"frequency": {
+			"type": "object",
+			"required": ["minimum", "maximum"],
+			"properties": {
+				"minimum": { "type": "integer", "minimum": 1 },
+				"maximum": { "type": "integer", "minimum": 0 }
+			}
+		}
+	}
+}`
+)
@@ -0,0 +1,450 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Validation
+ *
+ * This component provides the consistency validation of models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * Where the JSON schema only covers the structure of models, this validation covers the consistency of the
+ * references between the elements of a model, such as the involvement types used in readings.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+)
+
+/*
+ * Defining findings
+ */
+
+const (
+	FindingDanglingReference = "dangling reference" // An element refers to an element that does not exist (as such)
+	FindingReadingArity      = "reading arity"      // A reading does not use the involvement types of its relation type exactly once
+	FindingMissingReading    = "missing reading"    // A relation type has no readings
+	FindingMissingDomain     = "missing domain"     // A quality type has no domain
+	FindingDuplicateName     = "duplicate name"     // Several types have the same name
+	FindingCyclicSubtyping   = "cyclic subtyping"   // A type is a subtype of itself
+	FindingInvalidConstraint = "invalid constraint" // A constraint cannot be satisfied, or does not apply to its elements
+	FindingInvalidPopulation = "invalid population" // An instance or fact does not conform to its type
+	FindingViolation         = "violation"          // The population violates a constraint
+	FindingCyclicFact        = "cyclic fact"        // A fact nests itself, directly or indirectly
+)
+
+type (
+	// A finding of the validation of a model
+	TCDMFinding = connect.TFinding
+)
+
+/*
+ * Collecting findings
+ */
+
+// Getting the keys of a set, in sorted order, so the findings are reported in a predictable order
+func sortedKeys[V any](set map[string]V) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Adding a finding
+func addFinding(findings *[]TCDMFinding, kind, elementID, message string, context ...any) {
+	*findings = append(*findings, TCDMFinding{Kind: kind, ElementID: elementID, Message: fmt.Sprintf(message, context...)})
+}
+
+// Getting the label of an instance, or the ID of another element of the population
+func (m *TCDMModel) populationLabel(id string) string {
+	if label, labelled := m.LabelOfInstance[id]; labelled {
+		return label
+	}
+
+	return id
+}
+
+// Checking whether an ID refers to a type that can play a role in a relation type
+func (m *TCDMModel) isBaseType(id string) bool {
+	return m.ConcreteIndividualTypes[id] || m.QualityTypes[id] || m.RelationTypes[id]
+}
+
+/*
+ * Validating the parts of a model
+ */
+
+// Validating the quality types
+func (m *TCDMModel) validateQualityTypes(findings *[]TCDMFinding) {
+	for _, qualityType := range sortedKeys(m.QualityTypes) {
+		if m.DomainOfQualityType[qualityType] == "" {
+			addFinding(findings, FindingMissingDomain, qualityType, "quality type %q has no domain", m.TypeName[qualityType])
+		}
+	}
+}
+
+// Validating the involvement types
+func (m *TCDMModel) validateInvolvementTypes(findings *[]TCDMFinding) {
+	for _, involvementType := range sortedKeys(m.InvolvementTypes) {
+		// The base type should exist
+		baseType := m.BaseTypeOfInvolvementType[involvementType]
+		if !m.isBaseType(baseType) {
+			addFinding(findings, FindingDanglingReference, involvementType, "involvement type %q has unknown base type %q", m.TypeName[involvementType], baseType)
+		}
+
+		// The relation type should exist, and include the involvement type
+		relationType := m.RelationTypeOfInvolvementType[involvementType]
+		if !m.RelationTypes[relationType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "involvement type %q has unknown relation type %q", m.TypeName[involvementType], relationType)
+		} else if !m.InvolvementTypesOfRelationType[relationType][involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "involvement type %q is not an involvement type of its relation type %q", m.TypeName[involvementType], m.TypeName[relationType])
+		}
+	}
+
+	// No base or relation types for unknown involvement types
+	for _, involvementType := range sortedKeys(m.BaseTypeOfInvolvementType) {
+		if !m.InvolvementTypes[involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "base type given for unknown involvement type %q", involvementType)
+		}
+	}
+	for _, involvementType := range sortedKeys(m.RelationTypeOfInvolvementType) {
+		if !m.InvolvementTypes[involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "relation type given for unknown involvement type %q", involvementType)
+		}
+	}
+}
+
+// Validating a reading of a relation type
+func (m *TCDMModel) validateReading(relationType, reading string, findings *[]TCDMFinding) {
+	// The reading should be defined
+	readingDefinition, defined := m.ReadingDefinition[reading]
+	if !defined {
+		addFinding(findings, FindingDanglingReference, reading, "relation type %q has undefined reading %q", m.TypeName[relationType], reading)
+		return
+	}
+
+	// Each involvement type of the relation type should be used exactly once
+	used := map[string]int{}
+	for _, involvementType := range readingDefinition.InvolvementTypes {
+		used[involvementType]++
+		if !m.InvolvementTypesOfRelationType[relationType][involvementType] {
+			addFinding(findings, FindingReadingArity, reading, "reading of relation type %q uses involvement type %q of another relation type", m.TypeName[relationType], involvementType)
+		}
+	}
+	for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+		if used[involvementType] != 1 {
+			addFinding(findings, FindingReadingArity, reading, "reading of relation type %q uses involvement type %q %d times", m.TypeName[relationType], m.TypeName[involvementType], used[involvementType])
+		}
+	}
+
+	// The reading elements should surround the involvement types
+	if len(readingDefinition.ReadingElements) != len(readingDefinition.InvolvementTypes)+1 {
+		addFinding(findings, FindingReadingArity, reading, "reading of relation type %q has %d reading elements for %d involvement types", m.TypeName[relationType], len(readingDefinition.ReadingElements), len(readingDefinition.InvolvementTypes))
+	}
+}
+
+// Validating the relation types
+func (m *TCDMModel) validateRelationTypes(findings *[]TCDMFinding) {
+	for _, relationType := range sortedKeys(m.RelationTypes) {
+		// The involvement types should exist, and refer back to the relation type
+		for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+			if !m.InvolvementTypes[involvementType] {
+				addFinding(findings, FindingDanglingReference, relationType, "relation type %q has unknown involvement type %q", m.TypeName[relationType], involvementType)
+			} else if m.RelationTypeOfInvolvementType[involvementType] != relationType {
+				addFinding(findings, FindingDanglingReference, relationType, "involvement type %q of relation type %q belongs to another relation type", m.TypeName[involvementType], m.TypeName[relationType])
+			}
+		}
+
+		// There should be readings
+		readings := m.AlternativeReadingsOfRelationType[relationType]
+		if len(readings) == 0 {
+			addFinding(findings, FindingMissingReading, relationType, "relation type %q has no readings", m.TypeName[relationType])
+		}
+		for _, reading := range sortedKeys(readings) {
+			m.validateReading(relationType, reading, findings)
+		}
+
+		// The primary reading should be one of the readings
+		primaryReading := m.PrimaryReadingOfRelationType[relationType]
+		if primaryReading != "" && !readings[primaryReading] {
+			addFinding(findings, FindingDanglingReference, relationType, "the primary reading %q of relation type %q is not one of its readings", primaryReading, m.TypeName[relationType])
+		}
+	}
+}
+
+// Validating the subtyping of concrete individual types
+func (m *TCDMModel) validateSubtyping(findings *[]TCDMFinding) {
+	for _, subtype := range sortedKeys(m.SupertypesOfConcreteIndividualType) {
+		// The subtype and its supertypes should be concrete individual types
+		if !m.ConcreteIndividualTypes[subtype] {
+			addFinding(findings, FindingDanglingReference, subtype, "supertypes given for unknown concrete individual type %q", subtype)
+		}
+		for _, supertype := range sortedKeys(m.SupertypesOfConcreteIndividualType[subtype]) {
+			if !m.ConcreteIndividualTypes[supertype] {
+				addFinding(findings, FindingDanglingReference, subtype, "concrete individual type %q has unknown supertype %q", m.TypeName[subtype], supertype)
+			}
+		}
+
+		// The subtyping should be acyclic
+		if m.SupertypesOf(subtype)[subtype] {
+			addFinding(findings, FindingCyclicSubtyping, subtype, "concrete individual type %q is a subtype of itself", m.TypeName[subtype])
+		}
+	}
+}
+
+// Validating the constraints
+func (m *TCDMModel) validateConstraints(findings *[]TCDMFinding) {
+	// Uniqueness constraints should span involvement types of one relation type
+	for _, constraint := range sortedKeys(m.UniquenessConstraints) {
+		involvementTypes := m.InvolvementTypesOfUniquenessConstraint[constraint]
+		if len(involvementTypes) == 0 {
+			addFinding(findings, FindingInvalidConstraint, constraint, "uniqueness constraint %q spans no involvement types", constraint)
+		}
+
+		relationTypes := map[string]bool{}
+		for _, involvementType := range sortedKeys(involvementTypes) {
+			if !m.InvolvementTypes[involvementType] {
+				addFinding(findings, FindingDanglingReference, constraint, "uniqueness constraint %q spans unknown involvement type %q", constraint, involvementType)
+			} else {
+				relationTypes[m.RelationTypeOfInvolvementType[involvementType]] = true
+			}
+		}
+		if len(relationTypes) > 1 {
+			addFinding(findings, FindingInvalidConstraint, constraint, "uniqueness constraint %q spans involvement types of several relation types", constraint)
+		}
+	}
+	for _, constraint := range sortedKeys(m.InvolvementTypesOfUniquenessConstraint) {
+		if !m.UniquenessConstraints[constraint] {
+			addFinding(findings, FindingDanglingReference, constraint, "involvement types given for unknown uniqueness constraint %q", constraint)
+		}
+	}
+
+	// Mandatory and frequency constraints should be on existing involvement types
+	for _, involvementType := range sortedKeys(m.MandatoryInvolvementTypes) {
+		if !m.InvolvementTypes[involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "mandatory constraint on unknown involvement type %q", involvementType)
+		}
+	}
+	for _, involvementType := range sortedKeys(m.FrequencyOfInvolvementType) {
+		frequency := m.FrequencyOfInvolvementType[involvementType]
+		if !m.InvolvementTypes[involvementType] {
+			addFinding(findings, FindingDanglingReference, involvementType, "frequency constraint on unknown involvement type %q", involvementType)
+		}
+		if frequency.Minimum < 1 || (frequency.Maximum != 0 && frequency.Maximum < frequency.Minimum) {
+			addFinding(findings, FindingInvalidConstraint, involvementType, "involvement type %q has invalid frequency %d..%d", m.TypeName[involvementType], frequency.Minimum, frequency.Maximum)
+		}
+	}
+
+	// Value constraints should be on existing quality types, and allow some value
+	for _, qualityType := range sortedKeys(m.ValuesOfQualityType) {
+		if !m.QualityTypes[qualityType] {
+			addFinding(findings, FindingDanglingReference, qualityType, "value constraint on unknown quality type %q", qualityType)
+		}
+		if len(m.ValuesOfQualityType[qualityType]) == 0 {
+			addFinding(findings, FindingInvalidConstraint, qualityType, "value constraint of quality type %q allows no values", m.TypeName[qualityType])
+		}
+	}
+}
+
+// Validating a filler of an involvement type of a fact
+func (m *TCDMModel) validateFiller(fact, involvementType, filler string, findings *[]TCDMFinding) {
+	baseType := m.BaseTypeOfInvolvementType[involvementType]
+	switch {
+	case m.ConcreteIndividualTypes[baseType]:
+		if !m.Instances[filler] || !m.IsSubtypeOf(m.TypeOfInstance[filler], baseType) {
+			addFinding(findings, FindingInvalidPopulation, fact, "fact %q has %q as %q, which is not an instance of %q", fact, m.populationLabel(filler), m.TypeName[involvementType], m.TypeName[baseType])
+		}
+	case m.QualityTypes[baseType]:
+		if !m.IsAllowedValue(baseType, filler) {
+			addFinding(findings, FindingViolation, fact, "fact %q has value %q as %q, which is not allowed for %q", fact, filler, m.TypeName[involvementType], m.TypeName[baseType])
+		}
+	case m.RelationTypes[baseType]:
+		if !m.Facts[filler] || m.RelationTypeOfFact[filler] != baseType {
+			addFinding(findings, FindingInvalidPopulation, fact, "fact %q has %q as %q, which is not a fact of %q", fact, filler, m.TypeName[involvementType], m.TypeName[baseType])
+		}
+	}
+}
+
+// Validating the instances and facts against the types
+func (m *TCDMModel) validatePopulation(findings *[]TCDMFinding) {
+	// Instances should be of a concrete individual type
+	for _, instance := range sortedKeys(m.Instances) {
+		if !m.ConcreteIndividualTypes[m.TypeOfInstance[instance]] {
+			addFinding(findings, FindingInvalidPopulation, instance, "instance %q has unknown concrete individual type %q", m.LabelOfInstance[instance], m.TypeOfInstance[instance])
+		}
+	}
+
+	// Facts should fill the involvement types of their relation type
+	for _, fact := range sortedKeys(m.Facts) {
+		relationType := m.RelationTypeOfFact[fact]
+		if !m.RelationTypes[relationType] {
+			addFinding(findings, FindingInvalidPopulation, fact, "fact %q has unknown relation type %q", fact, relationType)
+			continue
+		}
+
+		// The nesting of facts should be acyclic
+		if m.nestedFactsOf(fact)[fact] {
+			addFinding(findings, FindingCyclicFact, fact, "fact %q nests itself", fact)
+		}
+
+		fillers := m.FillersOfFact[fact]
+		for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+			if filler, filled := fillers[involvementType]; !filled {
+				addFinding(findings, FindingInvalidPopulation, fact, "fact %q of %q does not fill %q", fact, m.TypeName[relationType], m.TypeName[involvementType])
+			} else {
+				m.validateFiller(fact, involvementType, filler, findings)
+			}
+		}
+		for _, involvementType := range sortedKeys(fillers) {
+			if !m.InvolvementTypesOfRelationType[relationType][involvementType] {
+				addFinding(findings, FindingInvalidPopulation, fact, "fact %q of %q fills involvement type %q of another relation type", fact, m.TypeName[relationType], involvementType)
+			}
+		}
+	}
+}
+
+// Validating the population against the uniqueness, mandatory and frequency constraints
+func (m *TCDMModel) validateViolations(findings *[]TCDMFinding) {
+	// No two facts should have the same fillers for the involvement types of a uniqueness constraint
+	for _, constraint := range sortedKeys(m.UniquenessConstraints) {
+		involvementTypes := sortedKeys(m.InvolvementTypesOfUniquenessConstraint[constraint])
+		factWithFillers := map[string]string{}
+		for _, fact := range sortedKeys(m.Facts) {
+			// Only facts of the constrained relation type
+			if len(involvementTypes) == 0 || m.RelationTypeOfFact[fact] != m.RelationTypeOfInvolvementType[involvementTypes[0]] {
+				continue
+			}
+
+			// The fillers of the constrained involvement types, as key
+			fillersKey := ""
+			for _, involvementType := range involvementTypes {
+				fillersKey += m.FillersOfFact[fact][involvementType] + "\x00"
+			}
+			if otherFact, taken := factWithFillers[fillersKey]; taken {
+				addFinding(findings, FindingViolation, fact, "facts %q and %q violate uniqueness constraint %q", otherFact, fact, constraint)
+			} else {
+				factWithFillers[fillersKey] = fact
+			}
+		}
+	}
+
+	// Count how often each filler plays each involvement type
+	timesPlayed := map[string]map[string]int{}
+	for _, fact := range sortedKeys(m.Facts) {
+		for involvementType, filler := range m.FillersOfFact[fact] {
+			if timesPlayed[involvementType] == nil {
+				timesPlayed[involvementType] = map[string]int{}
+			}
+			timesPlayed[involvementType][filler]++
+		}
+	}
+
+	// The instances and facts of the base type of a mandatory involvement type should play it
+	for _, involvementType := range sortedKeys(m.MandatoryInvolvementTypes) {
+		baseType := m.BaseTypeOfInvolvementType[involvementType]
+		players := m.InstancesOf(baseType)
+		if m.RelationTypes[baseType] {
+			players = m.FactsOf(baseType)
+		}
+		for _, player := range sortedKeys(players) {
+			if timesPlayed[involvementType][player] == 0 {
+				addFinding(findings, FindingViolation, player, "%q does not play mandatory involvement type %q", m.populationLabel(player), m.TypeName[involvementType])
+			}
+		}
+	}
+
+	// The fillers of an involvement type with a frequency constraint should play it the right number of times
+	for _, involvementType := range sortedKeys(m.FrequencyOfInvolvementType) {
+		frequency := m.FrequencyOfInvolvementType[involvementType]
+		for _, player := range sortedKeys(timesPlayed[involvementType]) {
+			times := timesPlayed[involvementType][player]
+			if times < frequency.Minimum || (frequency.Maximum != 0 && times > frequency.Maximum) {
+				addFinding(findings, FindingViolation, player, "%q plays %q %d times, violating its frequency constraint", m.populationLabel(player), m.TypeName[involvementType], times)
+			}
+		}
+	}
+}
+
+// Validating the names of the types
+func (m *TCDMModel) validateNames(findings *[]TCDMFinding) {
+	// The concrete individual, quality and relation types should have different names
+	typeWithName := map[string]string{}
+	for _, typeID := range sortedKeys(m.TypeName) {
+		if !m.isBaseType(typeID) {
+			continue
+		}
+
+		name := m.TypeName[typeID]
+		if otherType, taken := typeWithName[name]; taken {
+			addFinding(findings, FindingDuplicateName, typeID, "types %s and %s are both named %q", otherType, typeID, name)
+		} else {
+			typeWithName[name] = typeID
+		}
+	}
+
+	// The involvement types of a relation type should have different names
+	for _, relationType := range sortedKeys(m.RelationTypes) {
+		involvementTypeWithName := map[string]string{}
+		for _, involvementType := range sortedKeys(m.InvolvementTypesOfRelationType[relationType]) {
+			name := m.TypeName[involvementType]
+			if _, taken := involvementTypeWithName[name]; taken {
+				addFinding(findings, FindingDuplicateName, involvementType, "relation type %q has several involvement types named %q", m.TypeName[relationType], name)
+			} else {
+				involvementTypeWithName[name] = involvementType
+			}
+		}
+	}
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Validating the consistency of the model, returning the findings
+func (m *TCDMModel) Validate() []TCDMFinding {
+	findings := []TCDMFinding{}
+
+	m.validateQualityTypes(&findings)
+	m.validateInvolvementTypes(&findings)
+	m.validateRelationTypes(&findings)
+	m.validateSubtyping(&findings)
+	m.validateConstraints(&findings)
+	m.validatePopulation(&findings)
+	m.validateViolations(&findings)
+	m.validateNames(&findings)
+
+	return findings
+}
+
+// Validating the consistency of a model given as JSON, returning the findings as strings.
+// This is the validator used for the language registry.
+func ValidateModelJSON(modelJSON []byte) []string {
+	messages := []string{}
+
+	// Convert the JSON to a model
+	model := TCDMModel{}
+	model.Clean()
+	if err := json.Unmarshal(modelJSON, &model); err != nil {
+		return append(messages, err.Error())
+	}
+
+	// Validate the model
+	for _, finding := range model.Validate() {
+		messages = append(messages, finding.Kind+": "+finding.Message)
+	}
+
+	return messages
+}
@@ -0,0 +1,57 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Validation (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/languages/cdm/cdmtest"
+)
+
+func TestValidate(t *testing.T) {
+	cdmtest.RunKindsCases(t, []tKindsCase{
+		{Name: "valid model", Modify: func(m *TCDMModel, ids tTestModelIDs) {}, Kinds: []string{}},
+		{Name: "missing domain", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.DomainOfQualityType[ids.name] = ""
+		}, Kinds: []string{FindingMissingDomain}},
+		{Name: "missing reading", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RemoveReading(ids.reading)
+		}, Kinds: []string{FindingMissingReading}},
+		{Name: "duplicate name", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.RenameType(ids.company, "Person")
+		}, Kinds: []string{FindingDuplicateName}},
+		{Name: "cyclic subtyping", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SupertypesOfConcreteIndividualType[ids.person] = map[string]bool{ids.employee: true}
+		}, Kinds: []string{FindingCyclicSubtyping, FindingCyclicSubtyping}},
+		{Name: "unknown supertype", Modify: func(m *TCDMModel, ids tTestModelIDs) {
+			m.SupertypesOfConcreteIndividualType[ids.employee][ids.name] = true
+		}, Kinds: []string{FindingDanglingReference}},
+	}, findingKindsAfter)
+}
+
+func TestSubtyping(t *testing.T) {
+	model, ids := createTestModel()
+
+	if !model.IsSubtypeOf(ids.employee, ids.person) || model.IsSubtypeOf(ids.person, ids.employee) {
+		t.Errorf("employee should be a subtype of person, and not the other way around")
+	}
+	if model.AddSubtyping(ids.person, ids.employee) {
+		t.Errorf("cyclic subtyping should be rejected")
+	}
+	if model.AddSubtyping(ids.employee, ids.name) {
+		t.Errorf("subtyping of a quality type should be rejected")
+	}
+	if !model.CanPlayInvolvementType(ids.employee, ids.worker) || model.CanPlayInvolvementType(ids.person, ids.worker) {
+		t.Errorf("only employees should be able to play the worker involvement type")
+	}
+}
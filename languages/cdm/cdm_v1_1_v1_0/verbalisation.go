@@ -0,0 +1,289 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Verbalisation
+ *
+ * This component provides the natural language verbalisation of models expressed in the
+ *    Conceptual Domain Modelling language, Version 1.1
+ * Relation types are verbalised using their readings, where the involvement types are replaced by the names of
+ * their base types, while facts are verbalised using the primary reading of their relation type, where the
+ * involvement types are replaced by their fillers. Instances are named with their own type, which may be a
+ * subtype of the base type. Facts nesting themselves, as found in (invalid) models received, are verbalised by
+ * their ID when they recur.
+ * The verbalisations can be produced as plain text, Markdown, or LaTeX.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"sort"
+	"strings"
+)
+
+/*
+ * Defining key constants
+ */
+
+const (
+	VerbalisationFormatText     = "text"     // Plain text
+	VerbalisationFormatMarkdown = "markdown" // Markdown
+	VerbalisationFormatLaTeX    = "latex"    // LaTeX
+)
+
+var (
+	// The escaping of special characters in Markdown
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "#", `\#`, "[", `\[`, "]", `\]`,
+	)
+
+	// The escaping of special characters in LaTeX
+	latexEscaper = strings.NewReplacer(
+		`\`, `\textbackslash{}`, "{", `\{`, "}", `\}`, "$", `\$`, "&", `\&`, "#", `\#`,
+		"%", `\%`, "_", `\_`, "^", `\textasciicircum{}`, "~", `\textasciitilde{}`,
+	)
+)
+
+/*
+ * Defining the verbaliser
+ */
+
+type (
+	TCDMVerbaliser struct {
+		model  *TCDMModel // The model to be verbalised
+		format string     // The format of the verbalisations
+	}
+)
+
+/*
+ * Formatting verbalisations
+ */
+
+// Escaping the special characters of the format
+func (v *TCDMVerbaliser) escape(text string) string {
+	switch v.format {
+	case VerbalisationFormatMarkdown:
+		return markdownEscaper.Replace(text)
+	case VerbalisationFormatLaTeX:
+		return latexEscaper.Replace(text)
+	default:
+		return text
+	}
+}
+
+// Formatting the name of a type
+func (v *TCDMVerbaliser) formatTypeName(name string) string {
+	switch v.format {
+	case VerbalisationFormatMarkdown:
+		return "**" + v.escape(name) + "**"
+	case VerbalisationFormatLaTeX:
+		return `\textbf{` + v.escape(name) + "}"
+	default:
+		return name
+	}
+}
+
+// Formatting an instance label or value
+func (v *TCDMVerbaliser) formatValue(value string) string {
+	switch v.format {
+	case VerbalisationFormatMarkdown:
+		return "*" + v.escape(value) + "*"
+	case VerbalisationFormatLaTeX:
+		return `\emph{` + v.escape(value) + "}"
+	default:
+		return "'" + value + "'"
+	}
+}
+
+// Formatting a heading
+func (v *TCDMVerbaliser) formatHeading(level int, heading string) string {
+	switch v.format {
+	case VerbalisationFormatMarkdown:
+		return strings.Repeat("#", level) + " " + v.escape(heading) + "\n\n"
+	case VerbalisationFormatLaTeX:
+		return `\` + strings.Repeat("sub", level-1) + "section{" + v.escape(heading) + "}\n\n"
+	default:
+		return heading + "\n\n"
+	}
+}
+
+// Formatting a list of (already formatted) items
+func (v *TCDMVerbaliser) formatList(items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+
+	list := strings.Builder{}
+	if v.format == VerbalisationFormatLaTeX {
+		list.WriteString("\\begin{itemize}\n")
+	}
+	for _, item := range items {
+		if v.format == VerbalisationFormatLaTeX {
+			list.WriteString("  \\item " + item + "\n")
+		} else {
+			list.WriteString("- " + item + "\n")
+		}
+	}
+	if v.format == VerbalisationFormatLaTeX {
+		list.WriteString("\\end{itemize}\n")
+	}
+	list.WriteString("\n")
+
+	return list.String()
+}
+
+/*
+ * Verbalising readings
+ */
+
+// Verbalising a reading, where the involvement types are verbalised by the given function
+func (v *TCDMVerbaliser) verbaliseReadingWith(readingID string, verbaliseInvolvementType func(string) string) string {
+	reading := v.model.ReadingDefinition[readingID]
+
+	// Collect the non-empty parts of the sentence, to separate them by single spaces
+	parts := []string{}
+	for i, element := range reading.ReadingElements {
+		if part := strings.TrimSpace(v.escape(element)); part != "" {
+			parts = append(parts, part)
+		}
+		if i < len(reading.InvolvementTypes) {
+			if part := strings.TrimSpace(verbaliseInvolvementType(reading.InvolvementTypes[i])); part != "" {
+				parts = append(parts, part)
+			}
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(parts, " "))
+}
+
+// Verbalising a filler of an involvement type, which is an instance, a value, or a fact.
+// The facts being verbalised are visited, where a recurring fact is verbalised by its ID.
+func (v *TCDMVerbaliser) verbaliseFiller(involvementType, filler string, visited map[string]bool) string {
+	baseType := v.model.BaseTypeOfInvolvementType[involvementType]
+	typeName := v.formatTypeName(v.model.TypeName[baseType])
+
+	switch {
+	case v.model.ConcreteIndividualTypes[baseType]:
+		// An instance is verbalised with its own type, which may be a subtype of the base type
+		return v.formatTypeName(v.model.TypeName[v.model.TypeOfInstance[filler]]) + " " + v.formatValue(v.model.LabelOfInstance[filler])
+	case v.model.RelationTypes[baseType] && visited[filler]:
+		return typeName + " " + v.formatValue(filler)
+	case v.model.RelationTypes[baseType]:
+		return typeName + " (" + strings.TrimSuffix(v.verbaliseFactWith(filler, visited), ".") + ")"
+	default:
+		return typeName + " " + v.formatValue(filler)
+	}
+}
+
+// Verbalising a fact as a sentence, where the facts being verbalised are visited
+func (v *TCDMVerbaliser) verbaliseFactWith(factID string, visited map[string]bool) string {
+	relationType := v.model.RelationTypeOfFact[factID]
+	primaryReading := v.model.PrimaryReadingOfRelationType[relationType]
+	if primaryReading == "" {
+		return ""
+	}
+
+	// Visit the fact while verbalising its fillers
+	visited[factID] = true
+	defer delete(visited, factID)
+
+	return v.verbaliseReadingWith(primaryReading, func(involvementType string) string {
+		return v.verbaliseFiller(involvementType, v.model.FillersOfFact[factID][involvementType], visited)
+	}) + "."
+}
+
+// Getting the relation types, sorted by their names
+func (v *TCDMVerbaliser) relationTypesByName() []string {
+	relationTypes := sortedKeys(v.model.RelationTypes)
+	sort.SliceStable(relationTypes, func(i, j int) bool {
+		return v.model.TypeName[relationTypes[i]] < v.model.TypeName[relationTypes[j]]
+	})
+
+	return relationTypes
+}
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Setting the format of the verbalisations, being one of the VerbalisationFormat constants
+func (v *TCDMVerbaliser) UseFormat(format string) bool {
+	switch format {
+	case VerbalisationFormatText, VerbalisationFormatMarkdown, VerbalisationFormatLaTeX:
+		v.format = format
+
+		return true
+	default:
+		return false
+	}
+}
+
+// Verbalising a reading of a relation type, using the names of the base types of its involvement types
+func (v *TCDMVerbaliser) VerbaliseReading(readingID string) string {
+	return v.verbaliseReadingWith(readingID, func(involvementType string) string {
+		return v.formatTypeName(v.model.TypeName[v.model.BaseTypeOfInvolvementType[involvementType]])
+	})
+}
+
+// Verbalising the readings of a relation type, starting with its primary reading
+func (v *TCDMVerbaliser) VerbaliseRelationType(relationType string) []string {
+	verbalisations := []string{}
+
+	// The primary reading first
+	primaryReading := v.model.PrimaryReadingOfRelationType[relationType]
+	if primaryReading != "" {
+		verbalisations = append(verbalisations, v.VerbaliseReading(primaryReading))
+	}
+
+	// Followed by the alternative readings
+	for _, reading := range sortedKeys(v.model.AlternativeReadingsOfRelationType[relationType]) {
+		if reading != primaryReading {
+			verbalisations = append(verbalisations, v.VerbaliseReading(reading))
+		}
+	}
+
+	return verbalisations
+}
+
+// Verbalising a fact as a sentence, using the primary reading of its relation type
+func (v *TCDMVerbaliser) VerbaliseFact(factID string) string {
+	return v.verbaliseFactWith(factID, map[string]bool{})
+}
+
+// Verbalising the model as a document, listing the readings and example facts of each relation type
+func (v *TCDMVerbaliser) VerbaliseModel() string {
+	document := strings.Builder{}
+	document.WriteString(v.formatHeading(1, v.model.ModelName))
+
+	for _, relationType := range v.relationTypesByName() {
+		document.WriteString(v.formatHeading(2, v.model.TypeName[relationType]))
+		document.WriteString(v.formatList(v.VerbaliseRelationType(relationType)))
+
+		// The example facts
+		facts := []string{}
+		for _, fact := range sortedKeys(v.model.FactsOf(relationType)) {
+			facts = append(facts, v.VerbaliseFact(fact))
+		}
+		document.WriteString(v.formatList(facts))
+	}
+
+	return document.String()
+}
+
+// Creating a verbaliser for a model, producing plain text by default
+func CreateCDMVerbaliser(model *TCDMModel) TCDMVerbaliser {
+	// Setting up a new verbaliser
+	verbaliser := TCDMVerbaliser{}
+	verbaliser.model = model
+	verbaliser.format = VerbalisationFormatText
+
+	// Return the created verbaliser
+	return verbaliser
+}
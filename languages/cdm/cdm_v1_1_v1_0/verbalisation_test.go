@@ -0,0 +1,85 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Version 1.1
+ * Component: Verbalisation (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdm_v1_1_v1_0
+
+import (
+	"testing"
+)
+
+func TestVerbaliseFact(t *testing.T) {
+	model, ids := createPopulatedTestModel()
+
+	tests := []struct {
+		format        string
+		reading       string
+		verbalisation string
+	}{
+		{VerbalisationFormatText, "Employee works for Company", "Employee 'Alice' works for Company 'Acme'."},
+		{VerbalisationFormatMarkdown, "**Employee** works for **Company**", "**Employee** *Alice* works for **Company** *Acme*."},
+		{VerbalisationFormatLaTeX, `\textbf{Employee} works for \textbf{Company}`, `\textbf{Employee} \emph{Alice} works for \textbf{Company} \emph{Acme}.`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			verbaliser := CreateCDMVerbaliser(&model)
+			verbaliser.UseFormat(test.format)
+
+			if reading := verbaliser.VerbaliseReading(ids.reading); reading != test.reading {
+				t.Errorf("got reading %q, want %q", reading, test.reading)
+			}
+			if verbalisation := verbaliser.VerbaliseFact(ids.aliceWorksForAcme); verbalisation != test.verbalisation {
+				t.Errorf("got fact %q, want %q", verbalisation, test.verbalisation)
+			}
+		})
+	}
+}
+
+func TestVerbaliseFactOfSubtypeInstance(t *testing.T) {
+	model, ids := createPopulatedTestModel()
+	manager := model.AddConcreteIndividualType("Manager")
+	model.AddSubtyping(manager, ids.employee)
+	bob := model.AddInstance(manager, "Bob")
+	bobWorksForAcme := model.AddFact(ids.worksFor, map[string]string{ids.worker: bob, ids.employer: ids.acme})
+
+	// The instance is verbalised with its own type, rather than the base type of the involvement type
+	verbaliser := CreateCDMVerbaliser(&model)
+	if verbalisation, want := verbaliser.VerbaliseFact(bobWorksForAcme), "Manager 'Bob' works for Company 'Acme'."; verbalisation != want {
+		t.Errorf("got %q, want %q", verbalisation, want)
+	}
+}
+
+func TestVerbaliseCyclicFact(t *testing.T) {
+	tests := []struct {
+		name          string
+		facts         map[string]string
+		verbalisation string
+	}{
+		{"direct", map[string]string{"f1": "f1"}, "Amends 'f1' is amended."},
+		{"indirect", map[string]string{"f1": "f2", "f2": "f1"}, "Amends (Amends 'f1' is amended) is amended."},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			model, _ := createTestModel()
+			amends, amended := addSelfNestingRelationType(&model)
+			for fact, filler := range test.facts {
+				addUncheckedFact(&model, fact, amends, map[string]string{amended: filler})
+			}
+
+			verbaliser := CreateCDMVerbaliser(&model)
+			if verbalisation := verbaliser.VerbaliseFact("f1"); verbalisation != test.verbalisation {
+				t.Errorf("got %q, want %q", verbalisation, test.verbalisation)
+			}
+		})
+	}
+}
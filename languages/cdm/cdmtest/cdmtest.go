@@ -0,0 +1,88 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Conceptual Domain Modelling, Testing
+ * Component: Test harness
+ *
+ * This component provides the harness shared by the tests of the versions of the
+ * Conceptual Domain Modelling language.
+ * Each version defines its own test model (fixture), while the test cases, modifying the test model and
+ * listing the kinds of findings or changes expected as a result, are run in the same way for all versions.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package cdmtest
+
+import (
+	"slices"
+	"testing"
+)
+
+/*
+ * Defining test cases
+ */
+
+type (
+	// A test case modifying the test model, and the kinds (of findings or changes) expected as a result
+	TKindsCase[TModel, TIDs any] struct {
+		Name   string              // The name of the test case
+		Modify func(*TModel, TIDs) // The modification of the test model, given the IDs of its elements
+		Kinds  []string            // The kinds expected as a result of the modification
+	}
+
+	// A test case trying an operation on a model, and whether the operation is expected to succeed
+	TValidityCase struct {
+		Name  string      // The name of the test case
+		Try   func() bool // The operation, returning whether it succeeded
+		Valid bool        // Whether the operation is expected to succeed
+	}
+)
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Getting the kinds of findings or changes
+func Kinds[T any](elements []T, kindOf func(T) string) []string {
+	kinds := []string{}
+	for _, element := range elements {
+		kinds = append(kinds, kindOf(element))
+	}
+
+	return kinds
+}
+
+// Checking the kinds of findings or changes
+func AssertKinds(t testing.TB, got, want []string) {
+	t.Helper()
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+// Running test cases, where kindsAfter modifies a fresh test model and returns the resulting kinds
+func RunKindsCases[TModel, TIDs any](t *testing.T, cases []TKindsCase[TModel, TIDs], kindsAfter func(*testing.T, func(*TModel, TIDs)) []string) {
+	for _, test := range cases {
+		t.Run(test.Name, func(t *testing.T) {
+			AssertKinds(t, kindsAfter(t, test.Modify), test.Kinds)
+		})
+	}
+}
+
+// Running test cases, where the operations are tried in the given order
+func RunValidityCases(t *testing.T, cases []TValidityCase) {
+	for _, test := range cases {
+		t.Run(test.Name, func(t *testing.T) {
+			if valid := test.Try(); valid != test.Valid {
+				t.Errorf("got %t, want %t", valid, test.Valid)
+			}
+		})
+	}
+}
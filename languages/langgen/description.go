@@ -0,0 +1,188 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Language Generator
+ * Component: Meta-model Descriptions
+ *
+ * This component defines the declarative meta-model descriptions from which language packages are generated.
+ * A description is a JSON file listing the element kinds of the language, with their attributes and references.
+ * Names that are not given explicitly (such as plurals and JSON names) are derived from the Go names.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+)
+
+/*
+ * Defining meta-model descriptions
+ */
+
+type (
+	// The description of the meta-model of a language
+	tMetaModel struct {
+		Package      string         `json:"package"`       // The Go package of the language, e.g. "bpmn_v1_0_v1_0"
+		Language     string         `json:"language"`      // The (human readable) name of the language
+		JSONVersion  string         `json:"json version"`  // The JSON version identifier, e.g. "bpmn-v1.0-v1.0"
+		Model        string         `json:"model"`         // The name of the model type, without the T prefix, e.g. "BPMNModel"
+		ElementKinds []tElementKind `json:"element kinds"` // The kinds of elements in models
+	}
+
+	// The description of a kind of element
+	tElementKind struct {
+		Name       string       `json:"name"`                // The Go name of the element kind, e.g. "Task"
+		Plural     string       `json:"plural,omitempty"`    // The Go plural, defaulting to the name followed by an "s"
+		JSONName   string       `json:"json name,omitempty"` // The JSON name of the elements, defaulting to the plural in lower case words
+		Attributes []tAttribute `json:"attributes,omitempty"`
+		References []tReference `json:"references,omitempty"`
+	}
+
+	// The description of an attribute of an element kind
+	tAttribute struct {
+		Name     string `json:"name"`                // The Go name of the attribute, e.g. "Duration"
+		Plural   string `json:"plural,omitempty"`    // The Go plural, defaulting to the name followed by an "s"
+		Type     string `json:"type"`                // The Go type of the attribute values, e.g. "string" or "int"
+		JSONName string `json:"json name,omitempty"` // The JSON name, defaulting to "<plural> of <element kind plural>" in lower case words
+	}
+
+	// The description of a reference from an element kind to another element kind
+	tReference struct {
+		Name     string `json:"name"`                // The Go name of the reference, e.g. "Lane"
+		Plural   string `json:"plural,omitempty"`    // The Go plural, defaulting to the name followed by an "s"
+		Target   string `json:"target"`              // The name of the referenced element kind
+		Many     bool   `json:"many,omitempty"`      // Whether an element can refer to many elements
+		JSONName string `json:"json name,omitempty"` // The JSON name, defaulting to "<plural> of <element kind plural>" in lower case words
+	}
+)
+
+/*
+ * Deriving names
+ */
+
+// Splitting a Go name into lower case words, e.g. "QualityType" into "quality type"
+func lowerCaseWords(name string) string {
+	words := []string{}
+	word := []rune{}
+	runes := []rune(name)
+	for i, r := range runes {
+		// A new word starts at an upper case letter, unless it continues an acronym
+		startsWord := unicode.IsUpper(r) && i > 0 &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+		if startsWord && len(word) > 0 {
+			words = append(words, string(word))
+			word = []rune{}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	// Acronyms remain upper case
+	for i, word := range words {
+		if strings.ToUpper(word) != word || len(word) == 1 {
+			words[i] = strings.ToLower(word)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Defaulting a plural
+func pluralOf(name, plural string) string {
+	if plural != "" {
+		return plural
+	}
+
+	return name + "s"
+}
+
+/*
+ * Reading and completing descriptions
+ */
+
+// Completing the description with the derived names, and checking its consistency
+func (m *tMetaModel) complete() error {
+	// Check the general properties
+	if m.Package == "" || m.JSONVersion == "" || m.Model == "" {
+		return fmt.Errorf("the package, json version and model should be given")
+	}
+
+	// Complete the element kinds
+	kinds := map[string]bool{}
+	for k := range m.ElementKinds {
+		kind := &m.ElementKinds[k]
+		if kind.Name == "" {
+			return fmt.Errorf("element kind %d has no name", k+1)
+		}
+		kinds[kind.Name] = true
+
+		kind.Plural = pluralOf(kind.Name, kind.Plural)
+		if kind.JSONName == "" {
+			kind.JSONName = lowerCaseWords(kind.Plural)
+		}
+
+		for a := range kind.Attributes {
+			attribute := &kind.Attributes[a]
+			if attribute.Name == "" || attribute.Type == "" {
+				return fmt.Errorf("attribute %d of %s should have a name and a type", a+1, kind.Name)
+			}
+			attribute.Plural = pluralOf(attribute.Name, attribute.Plural)
+			if attribute.JSONName == "" {
+				attribute.JSONName = lowerCaseWords(attribute.Plural) + " of " + kind.JSONName
+			}
+		}
+
+		for r := range kind.References {
+			reference := &kind.References[r]
+			if reference.Name == "" || reference.Target == "" {
+				return fmt.Errorf("reference %d of %s should have a name and a target", r+1, kind.Name)
+			}
+			reference.Plural = pluralOf(reference.Name, reference.Plural)
+			if reference.JSONName == "" {
+				reference.JSONName = lowerCaseWords(reference.Plural) + " of " + kind.JSONName
+			}
+		}
+	}
+
+	// Check the targets of the references
+	for _, kind := range m.ElementKinds {
+		for _, reference := range kind.References {
+			if !kinds[reference.Target] {
+				return fmt.Errorf("reference %s of %s refers to unknown element kind %s", reference.Name, kind.Name, reference.Target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reading a meta-model description from a file
+func readMetaModel(fileName string) (tMetaModel, error) {
+	metaModel := tMetaModel{}
+
+	// Read the file
+	descriptionJSON, err := os.ReadFile(fileName)
+	if err != nil {
+		return metaModel, err
+	}
+
+	// Unmarshal the description
+	decoder := json.NewDecoder(strings.NewReader(string(descriptionJSON)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&metaModel); err != nil {
+		return metaModel, err
+	}
+
+	return metaModel, metaModel.complete()
+}
@@ -0,0 +1,30 @@
+// Code generated by langgen from metamodel.json; DO NOT EDIT.
+
+package flow_v1_0_v1_0
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+)
+
+/*
+ * Defining the model poster and listener
+ */
+
+type (
+	TFlowModelPoster   = connect.TPoster[TFlowModel]   // The poster of models
+	TFlowModelListener = connect.TListener[TFlowModel] // The listener for models
+)
+
+/*
+ * Creating the model poster and listener
+ */
+
+// Creating a model poster, which uses a given ModellingBusConnector to post the model
+func CreateFlowModelPoster(ModellingBusConnector connect.TModellingBusConnector, modelID string) TFlowModelPoster {
+	return connect.CreatePoster[TFlowModel](ModellingBusConnector, ModelJSONVersion, modelID)
+}
+
+// Creating a model listener, which uses a given ModellingBusConnector to listen for models and their updates
+func CreateFlowModelListener(ModellingBusConnector connect.TModellingBusConnector) TFlowModelListener {
+	return connect.CreateListener[TFlowModel](ModellingBusConnector, ModelJSONVersion)
+}
@@ -0,0 +1,185 @@
+// Code generated by langgen from metamodel.json; DO NOT EDIT.
+
+package flow_v1_0_v1_0
+
+import (
+	"encoding/json"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining key constants
+ */
+
+const (
+	ModelJSONVersion = "flow-v1.0-v1.0" // The JSON version identifier for Flow Modelling, Version 1 models
+)
+
+/*
+ * Defining the model structure, including the JSON structure
+ */
+
+type (
+	TFlowModel struct {
+		// For reporting errors
+		reporter *generics.TReporter // The Reporter to be used to report progress, errors, and panics
+
+		// General properties for the model
+		ModelName string `json:"model name"` // The name of the model
+
+		// For lanes
+		Lanes       map[string]bool   `json:"lanes"`          // The lanes
+		NamesOfLane map[string]string `json:"names of lanes"` // The names of lanes
+
+		// For tasks
+		Tasks            map[string]bool            `json:"tasks"`               // The tasks
+		NamesOfTask      map[string]string          `json:"names of tasks"`      // The names of tasks
+		DurationsOfTask  map[string]int             `json:"durations of tasks"`  // The durations of tasks
+		LanesOfTask      map[string]string          `json:"lanes of tasks"`      // The lanes of tasks
+		SuccessorsOfTask map[string]map[string]bool `json:"successors of tasks"` // The successors of tasks
+	}
+)
+
+/*
+ * Converting JSON to models and back
+ */
+
+// Converting the model to JSON
+func (m *TFlowModel) GetModelAsJSON() (json.RawMessage, bool) {
+	modelJSON, err := json.Marshal(m)
+
+	// Handle potential errors
+	if m.reporter.MaybeReportError("Something went wrong when converting model to JSON.", err) {
+		return []byte{}, false
+	}
+
+	return modelJSON, true
+}
+
+// Converting the JSON to the model
+func (m *TFlowModel) SetModelFromJSON(modelJSON json.RawMessage) bool {
+	m.Clean()
+	err := json.Unmarshal(modelJSON, m)
+
+	// Handle potential errors
+	if m.reporter.MaybeReportError("Something went wrong when converting JSON to model.", err) {
+		return false
+	}
+
+	return true
+}
+
+/*
+ * Functionality related to the model
+ */
+
+// Generating a new element ID
+func (m *TFlowModel) NewElementID() string {
+	return generics.GetTimestamp()
+}
+
+// Setting the model name
+func (m *TFlowModel) SetModelName(name string) {
+	m.ModelName = name
+}
+
+// Adding an element to the lanes
+func (m *TFlowModel) AddLane(name string) string {
+	id := m.NewElementID()
+	m.Lanes[id] = true
+	m.NamesOfLane[id] = name
+
+	return id
+}
+
+// Setting the name of an element of the lanes
+func (m *TFlowModel) SetNameOfLane(id string, name string) {
+	m.NamesOfLane[id] = name
+}
+
+// Removing an element of the lanes, including the references to it
+func (m *TFlowModel) RemoveLane(id string) {
+	delete(m.Lanes, id)
+	delete(m.NamesOfLane, id)
+	for source, target := range m.LanesOfTask {
+		if target == id {
+			delete(m.LanesOfTask, source)
+		}
+	}
+}
+
+// Adding an element to the tasks
+func (m *TFlowModel) AddTask(name string, duration int) string {
+	id := m.NewElementID()
+	m.Tasks[id] = true
+	m.NamesOfTask[id] = name
+	m.DurationsOfTask[id] = duration
+
+	return id
+}
+
+// Setting the name of an element of the tasks
+func (m *TFlowModel) SetNameOfTask(id string, name string) {
+	m.NamesOfTask[id] = name
+}
+
+// Setting the duration of an element of the tasks
+func (m *TFlowModel) SetDurationOfTask(id string, duration int) {
+	m.DurationsOfTask[id] = duration
+}
+
+// Setting the lane of an element of the tasks
+func (m *TFlowModel) SetLaneOfTask(id, target string) {
+	m.LanesOfTask[id] = target
+}
+
+// Adding a successor to an element of the tasks
+func (m *TFlowModel) AddSuccessorOfTask(id, target string) {
+	if m.SuccessorsOfTask[id] == nil {
+		m.SuccessorsOfTask[id] = map[string]bool{}
+	}
+	m.SuccessorsOfTask[id][target] = true
+}
+
+// Removing a successor from an element of the tasks
+func (m *TFlowModel) RemoveSuccessorOfTask(id, target string) {
+	delete(m.SuccessorsOfTask[id], target)
+}
+
+// Removing an element of the tasks, including the references to it
+func (m *TFlowModel) RemoveTask(id string) {
+	delete(m.Tasks, id)
+	delete(m.NamesOfTask, id)
+	delete(m.DurationsOfTask, id)
+	delete(m.LanesOfTask, id)
+	delete(m.SuccessorsOfTask, id)
+	for _, targets := range m.SuccessorsOfTask {
+		delete(targets, id)
+	}
+}
+
+/*
+ * Creating & cleaning models
+ */
+
+// Cleaning a model
+func (m *TFlowModel) Clean() {
+	m.ModelName = ""
+	m.Lanes = map[string]bool{}
+	m.NamesOfLane = map[string]string{}
+	m.Tasks = map[string]bool{}
+	m.NamesOfTask = map[string]string{}
+	m.DurationsOfTask = map[string]int{}
+	m.LanesOfTask = map[string]string{}
+	m.SuccessorsOfTask = map[string]map[string]bool{}
+}
+
+// Creating a new model
+func CreateFlowModel(reporter *generics.TReporter) TFlowModel {
+	model := TFlowModel{}
+	model.Clean()
+	model.reporter = reporter
+
+	return model
+}
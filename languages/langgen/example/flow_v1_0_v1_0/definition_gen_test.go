@@ -0,0 +1,38 @@
+// Code generated by langgen from metamodel.json; DO NOT EDIT.
+
+package flow_v1_0_v1_0
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+// Testing that a model survives the conversion to JSON and back
+func TestModelJSONRoundTrip(t *testing.T) {
+	reporter := generics.CreateReporter(0, func(message string) { t.Error(message) }, func(string) {})
+
+	// Build a model using all element kinds
+	model := CreateFlowModel(reporter)
+	model.SetModelName("round trip")
+	laneID := model.AddLane("example")
+	taskID := model.AddTask("example", 1)
+	model.SetLaneOfTask(taskID, laneID)
+	model.AddSuccessorOfTask(taskID, taskID)
+
+	// Convert it to JSON and back
+	modelJSON, ok := model.GetModelAsJSON()
+	if !ok {
+		t.Fatal("converting the model to JSON failed")
+	}
+	roundTripModel := CreateFlowModel(reporter)
+	if !roundTripModel.SetModelFromJSON(modelJSON) {
+		t.Fatal("converting the JSON to a model failed")
+	}
+
+	// Compare
+	if !reflect.DeepEqual(model, roundTripModel) {
+		t.Errorf("the model changed in the round trip:\n%v\n%v", model, roundTripModel)
+	}
+}
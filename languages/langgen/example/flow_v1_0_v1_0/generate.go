@@ -0,0 +1,19 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Language Generator, Example
+ * Component: Generation
+ *
+ * This package is generated from the example meta-model description documented in the language generator,
+ * and shows what a generated language package looks like. The language generator checks, in its tests, that
+ * the files of this package are generated as committed.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+//go:generate go run github.com/erikproper/big-modelling-bus.go.v1/languages/langgen -description metamodel.json -tests
+
+package flow_v1_0_v1_0
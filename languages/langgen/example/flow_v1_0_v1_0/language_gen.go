@@ -0,0 +1,32 @@
+// Code generated by langgen from metamodel.json; DO NOT EDIT.
+
+package flow_v1_0_v1_0
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+	"github.com/erikproper/big-modelling-bus.go.v1/languages"
+)
+
+/*
+ * Registering the language
+ */
+
+// Creating an empty model, for the language registry
+func newModel(reporter *generics.TReporter) any {
+	model := CreateFlowModel(reporter)
+
+	return &model
+}
+
+func init() {
+	// Define the language
+	language := languages.TLanguage{}
+	language.JSONVersion = ModelJSONVersion
+	language.Name = "Flow Modelling, Version 1"
+	language.NewModel = newModel
+
+	// Register it
+	if err := languages.Register(language); err != nil {
+		panic(err)
+	}
+}
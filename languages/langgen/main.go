@@ -0,0 +1,171 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Language Generator
+ * Component: Main
+ *
+ * This tool generates a language package from a declarative meta-model description. The generated package
+ * contains the Go model type (with JSON tags), its constructor and cleaning, operations to add, set and remove
+ * elements, the poster and listener for the modelling bus, and the registration of the language.
+ * Round-trip tests can be generated as well.
+ *
+ * It is intended to be used with go generate, by placing the following line in a file of the language package:
+ *
+ *    //go:generate go run github.com/erikproper/big-modelling-bus.go.v1/languages/langgen -description metamodel.json
+ *
+ * An example meta-model description:
+ *
+ *    {
+ *      "package": "flow_v1_0_v1_0",
+ *      "language": "Flow Modelling, Version 1",
+ *      "json version": "flow-v1.0-v1.0",
+ *      "model": "FlowModel",
+ *      "element kinds": [
+ *        { "name": "Lane", "attributes": [ { "name": "Name", "type": "string" } ] },
+ *        { "name": "Task",
+ *          "attributes": [ { "name": "Name", "type": "string" }, { "name": "Duration", "type": "int" } ],
+ *          "references": [ { "name": "Lane", "target": "Lane" },
+ *                          { "name": "Successor", "target": "Task", "many": true } ] }
+ *      ]
+ *    }
+ *
+ * The package generated from this example, with its round-trip tests, is kept in example/flow_v1_0_v1_0.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+/*
+ * Defining the generated files
+ */
+
+type (
+	// A file to be generated
+	tGeneratedFile struct {
+		fileName string // The name of the file
+		template string // The template for the file
+	}
+
+	// The data available to the templates
+	tTemplateData struct {
+		tMetaModel
+		Source string // The name of the meta-model description
+	}
+)
+
+var (
+	generatedFiles = []tGeneratedFile{
+		{"definition_gen.go", definitionTemplate},
+		{"connecting_gen.go", connectingTemplate},
+		{"language_gen.go", languageTemplate},
+	}
+
+	generatedTestFile = tGeneratedFile{"definition_gen_test.go", testsTemplate}
+)
+
+/*
+ * Template functions
+ */
+
+// Turning the first letter of a name into lower case, e.g. for parameter names
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	if len(runes) == 0 {
+		return name
+	}
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// Getting a (non-zero) example value of a Go type, for the tests
+func exampleValue(goType string) string {
+	switch {
+	case goType == "string":
+		return `"example"`
+	case goType == "bool":
+		return "true"
+	case strings.HasPrefix(goType, "int"), strings.HasPrefix(goType, "uint"), strings.HasPrefix(goType, "float"):
+		return "1"
+	default:
+		return goType + "{}"
+	}
+}
+
+/*
+ * Generating files
+ */
+
+// Generating a file from its template, and writing it to the output directory
+func generateFile(file tGeneratedFile, data tTemplateData, outputDirectory string) error {
+	// Prepare the template
+	functions := template.FuncMap{"lowerFirst": lowerFirst, "lowerWords": lowerCaseWords, "exampleValue": exampleValue}
+	fileTemplate, err := template.New(file.fileName).Funcs(functions).Parse(file.template)
+	if err != nil {
+		return err
+	}
+
+	// Execute it
+	source := bytes.Buffer{}
+	if err := fileTemplate.Execute(&source, data); err != nil {
+		return err
+	}
+
+	// Format the result
+	formattedSource, err := format.Source(source.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", file.fileName, err)
+	}
+
+	// Write it
+	return os.WriteFile(filepath.Join(outputDirectory, file.fileName), formattedSource, 0644)
+}
+
+/*
+ * Main
+ */
+
+func main() {
+	description := flag.String("description", "metamodel.json", "The meta-model description")
+	outputDirectory := flag.String("output", ".", "The directory of the language package")
+	withTests := flag.Bool("tests", false, "Also generate round-trip tests")
+	flag.Parse()
+
+	// Read the meta-model description
+	metaModel, err := readMetaModel(*description)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "langgen: reading %s: %s\n", *description, err)
+		os.Exit(1)
+	}
+
+	// Determine the files to generate
+	files := generatedFiles
+	if *withTests {
+		files = append(files, generatedTestFile)
+	}
+
+	// Generate them
+	data := tTemplateData{metaModel, filepath.Base(*description)}
+	for _, file := range files {
+		if err := generateFile(file, data, *outputDirectory); err != nil {
+			fmt.Fprintf(os.Stderr, "langgen: generating %s: %s\n", file.fileName, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Language Generator
+ * Component: Main (tests)
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The example package, generated from the example meta-model description
+const exampleDirectory = "example/flow_v1_0_v1_0"
+
+func TestGenerateExample(t *testing.T) {
+	description := filepath.Join(exampleDirectory, "metamodel.json")
+	metaModel, err := readMetaModel(description)
+	if err != nil {
+		t.Fatalf("reading %s: %v", description, err)
+	}
+
+	// Generate the files, including the tests, as go run ./languages/langgen -tests does
+	outputDirectory := t.TempDir()
+	data := tTemplateData{metaModel, filepath.Base(description)}
+	for _, file := range append(generatedFiles, generatedTestFile) {
+		t.Run(file.fileName, func(t *testing.T) {
+			if err := generateFile(file, data, outputDirectory); err != nil {
+				t.Fatalf("generating: %v", err)
+			}
+
+			generated, err := os.ReadFile(filepath.Join(outputDirectory, file.fileName))
+			if err != nil {
+				t.Fatalf("reading the generated file: %v", err)
+			}
+
+			// The generated file should be formatted as gofmt does
+			formatted, err := format.Source(generated)
+			if err != nil {
+				t.Fatalf("the generated file is not valid Go: %v", err)
+			}
+			if !bytes.Equal(formatted, generated) {
+				t.Errorf("the generated file is not formatted as gofmt does")
+			}
+
+			// The example package should be generated as committed
+			committed, err := os.ReadFile(filepath.Join(exampleDirectory, file.fileName))
+			if err != nil {
+				t.Fatalf("reading the example file: %v", err)
+			}
+			if !bytes.Equal(committed, generated) {
+				t.Errorf("the example file differs from the generated one, so run go generate in %s", exampleDirectory)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages/Language Generator
+ * Component: Templates
+ *
+ * This component provides the templates for the files of generated language packages.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package main
+
+/*
+ * Defining the templates
+ */
+
+const (
+	// The header of all generated files
+	headerTemplate = `// Code generated by langgen from {{.Source}}; DO NOT EDIT.
+
+`
+
+	// The definition of the model type
+	definitionTemplate = headerTemplate + `package {{.Package}}
+
+import (
+	"encoding/json"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining key constants
+ */
+
+const (
+	ModelJSONVersion = "{{.JSONVersion}}" // The JSON version identifier for {{.Language}} models
+)
+
+/*
+ * Defining the model structure, including the JSON structure
+ */
+
+type (
+	T{{.Model}} struct {
+		// For reporting errors
+		reporter *generics.TReporter // The Reporter to be used to report progress, errors, and panics
+
+		// General properties for the model
+		ModelName string ` + "`" + `json:"model name"` + "`" + ` // The name of the model
+{{range .ElementKinds}}{{$kind := .}}
+		// For {{.JSONName}}
+		{{.Plural}} map[string]bool ` + "`" + `json:"{{.JSONName}}"` + "`" + ` // The {{.JSONName}}
+{{- range .Attributes}}
+		{{.Plural}}Of{{$kind.Name}} map[string]{{.Type}} ` + "`" + `json:"{{.JSONName}}"` + "`" + ` // The {{.JSONName}}
+{{- end}}
+{{- range .References}}
+		{{.Plural}}Of{{$kind.Name}} map[string]{{if .Many}}map[string]bool{{else}}string{{end}} ` + "`" + `json:"{{.JSONName}}"` + "`" + ` // The {{.JSONName}}
+{{- end}}
+{{end}}	}
+)
+
+/*
+ * Converting JSON to models and back
+ */
+
+// Converting the model to JSON
+func (m *T{{.Model}}) GetModelAsJSON() (json.RawMessage, bool) {
+	modelJSON, err := json.Marshal(m)
+
+	// Handle potential errors
+	if m.reporter.MaybeReportError("Something went wrong when converting model to JSON.", err) {
+		return []byte{}, false
+	}
+
+	return modelJSON, true
+}
+
+// Converting the JSON to the model
+func (m *T{{.Model}}) SetModelFromJSON(modelJSON json.RawMessage) bool {
+	m.Clean()
+	err := json.Unmarshal(modelJSON, m)
+
+	// Handle potential errors
+	if m.reporter.MaybeReportError("Something went wrong when converting JSON to model.", err) {
+		return false
+	}
+
+	return true
+}
+
+/*
+ * Functionality related to the model
+ */
+
+// Generating a new element ID
+func (m *T{{.Model}}) NewElementID() string {
+	return generics.GetTimestamp()
+}
+
+// Setting the model name
+func (m *T{{.Model}}) SetModelName(name string) {
+	m.ModelName = name
+}
+{{range .ElementKinds}}{{$kind := .}}
+// Adding an element to the {{.JSONName}}
+func (m *T{{$.Model}}) Add{{.Name}}({{range $i, $a := .Attributes}}{{if $i}}, {{end}}{{lowerFirst $a.Name}} {{$a.Type}}{{end}}) string {
+	id := m.NewElementID()
+	m.{{.Plural}}[id] = true
+{{- range .Attributes}}
+	m.{{.Plural}}Of{{$kind.Name}}[id] = {{lowerFirst .Name}}
+{{- end}}
+
+	return id
+}
+{{range .Attributes}}
+// Setting the {{lowerWords .Name}} of an element of the {{$kind.JSONName}}
+func (m *T{{$.Model}}) Set{{.Name}}Of{{$kind.Name}}(id string, {{lowerFirst .Name}} {{.Type}}) {
+	m.{{.Plural}}Of{{$kind.Name}}[id] = {{lowerFirst .Name}}
+}
+{{end}}
+{{- range .References}}{{if .Many}}
+// Adding a {{lowerWords .Name}} to an element of the {{$kind.JSONName}}
+func (m *T{{$.Model}}) Add{{.Name}}Of{{$kind.Name}}(id, target string) {
+	if m.{{.Plural}}Of{{$kind.Name}}[id] == nil {
+		m.{{.Plural}}Of{{$kind.Name}}[id] = map[string]bool{}
+	}
+	m.{{.Plural}}Of{{$kind.Name}}[id][target] = true
+}
+
+// Removing a {{lowerWords .Name}} from an element of the {{$kind.JSONName}}
+func (m *T{{$.Model}}) Remove{{.Name}}Of{{$kind.Name}}(id, target string) {
+	delete(m.{{.Plural}}Of{{$kind.Name}}[id], target)
+}
+{{else}}
+// Setting the {{lowerWords .Name}} of an element of the {{$kind.JSONName}}
+func (m *T{{$.Model}}) Set{{.Name}}Of{{$kind.Name}}(id, target string) {
+	m.{{.Plural}}Of{{$kind.Name}}[id] = target
+}
+{{end}}{{end}}
+// Removing an element of the {{.JSONName}}, including the references to it
+func (m *T{{$.Model}}) Remove{{.Name}}(id string) {
+	delete(m.{{.Plural}}, id)
+{{- range .Attributes}}
+	delete(m.{{.Plural}}Of{{$kind.Name}}, id)
+{{- end}}
+{{- range .References}}
+	delete(m.{{.Plural}}Of{{$kind.Name}}, id)
+{{- end}}
+{{- range $.ElementKinds}}{{$source := .}}{{range .References}}{{if eq .Target $kind.Name}}
+{{- if .Many}}
+	for _, targets := range m.{{.Plural}}Of{{$source.Name}} {
+		delete(targets, id)
+	}
+{{- else}}
+	for source, target := range m.{{.Plural}}Of{{$source.Name}} {
+		if target == id {
+			delete(m.{{.Plural}}Of{{$source.Name}}, source)
+		}
+	}
+{{- end}}
+{{- end}}{{end}}{{end}}
+}
+{{end}}
+/*
+ * Creating & cleaning models
+ */
+
+// Cleaning a model
+func (m *T{{.Model}}) Clean() {
+	m.ModelName = ""
+{{- range .ElementKinds}}{{$kind := .}}
+	m.{{.Plural}} = map[string]bool{}
+{{- range .Attributes}}
+	m.{{.Plural}}Of{{$kind.Name}} = map[string]{{.Type}}{}
+{{- end}}
+{{- range .References}}
+	m.{{.Plural}}Of{{$kind.Name}} = map[string]{{if .Many}}map[string]bool{{else}}string{{end}}{}
+{{- end}}
+{{- end}}
+}
+
+// Creating a new model
+func Create{{.Model}}(reporter *generics.TReporter) T{{.Model}} {
+	model := T{{.Model}}{}
+	model.Clean()
+	model.reporter = reporter
+
+	return model
+}
+`
+
+	// The posting and listening wrappers
+	connectingTemplate = headerTemplate + `package {{.Package}}
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+)
+
+/*
+ * Defining the model poster and listener
+ */
+
+type (
+	T{{.Model}}Poster   = connect.TPoster[T{{.Model}}]   // The poster of models
+	T{{.Model}}Listener = connect.TListener[T{{.Model}}] // The listener for models
+)
+
+/*
+ * Creating the model poster and listener
+ */
+
+// Creating a model poster, which uses a given ModellingBusConnector to post the model
+func Create{{.Model}}Poster(ModellingBusConnector connect.TModellingBusConnector, modelID string) T{{.Model}}Poster {
+	return connect.CreatePoster[T{{.Model}}](ModellingBusConnector, ModelJSONVersion, modelID)
+}
+
+// Creating a model listener, which uses a given ModellingBusConnector to listen for models and their updates
+func Create{{.Model}}Listener(ModellingBusConnector connect.TModellingBusConnector) T{{.Model}}Listener {
+	return connect.CreateListener[T{{.Model}}](ModellingBusConnector, ModelJSONVersion)
+}
+`
+
+	// The registration of the language
+	languageTemplate = headerTemplate + `package {{.Package}}
+
+import (
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+	"github.com/erikproper/big-modelling-bus.go.v1/languages"
+)
+
+/*
+ * Registering the language
+ */
+
+// Creating an empty model, for the language registry
+func newModel(reporter *generics.TReporter) any {
+	model := Create{{.Model}}(reporter)
+
+	return &model
+}
+
+func init() {
+	// Define the language
+	language := languages.TLanguage{}
+	language.JSONVersion = ModelJSONVersion
+	language.Name = "{{.Language}}"
+	language.NewModel = newModel
+
+	// Register it
+	if err := languages.Register(language); err != nil {
+		panic(err)
+	}
+}
+`
+
+	// The round-trip tests
+	testsTemplate = headerTemplate + `package {{.Package}}
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+// Testing that a model survives the conversion to JSON and back
+func TestModelJSONRoundTrip(t *testing.T) {
+	reporter := generics.CreateReporter(0, func(message string) { t.Error(message) }, func(string) {})
+
+	// Build a model using all element kinds
+	model := Create{{.Model}}(reporter)
+	model.SetModelName("round trip")
+{{- range .ElementKinds}}{{$kind := .}}
+	{{lowerFirst .Name}}ID := model.Add{{.Name}}({{range $i, $a := .Attributes}}{{if $i}}, {{end}}{{exampleValue $a.Type}}{{end}})
+{{- end}}
+{{- range .ElementKinds}}{{$kind := .}}{{range .References}}
+	model.{{if .Many}}Add{{else}}Set{{end}}{{.Name}}Of{{$kind.Name}}({{lowerFirst $kind.Name}}ID, {{lowerFirst .Target}}ID)
+{{- end}}{{end}}
+
+	// Convert it to JSON and back
+	modelJSON, ok := model.GetModelAsJSON()
+	if !ok {
+		t.Fatal("converting the model to JSON failed")
+	}
+	roundTripModel := Create{{.Model}}(reporter)
+	if !roundTripModel.SetModelFromJSON(modelJSON) {
+		t.Fatal("converting the JSON to a model failed")
+	}
+
+	// Compare
+	if !reflect.DeepEqual(model, roundTripModel) {
+		t.Errorf("the model changed in the round trip:\n%v\n%v", model, roundTripModel)
+	}
+}
+`
+)
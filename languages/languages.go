@@ -0,0 +1,176 @@
+/*
+ *
+ * Module:    BIG Modelling Bus, Version 1
+ * Package:   Languages
+ * Component: Registry
+ *
+ * This component provides the registry of the modelling languages used on the BIG Modelling Bus.
+ * Language packages register themselves (typically in their init function) by their JSON version, i.e. the
+ * path element used for their artefacts on the bus. This allows generic tools, such as bus monitors, gateways
+ * and exporters, to decode any artefact they encounter by its JSON version.
+ *
+ * Registering a language also registers its JSON schema, and converters to other JSON versions, with the
+ * connect package, so that artefact connectors validate and migrate the artefacts of the language.
+ *
+ * Note: a language package needs to be imported (possibly as a blank import) for it to be registered.
+ *
+ * Creator: Henderik A. Proper (e.proper@acm.org), TU Wien, Austria
+ *
+ * Version of: 18.10.2026
+ *
+ */
+
+package languages
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/erikproper/big-modelling-bus.go.v1/connect"
+	"github.com/erikproper/big-modelling-bus.go.v1/generics"
+)
+
+/*
+ * Defining languages
+ */
+
+type (
+	// A modelling language, in a specific JSON version
+	TLanguage struct {
+		JSONVersion string                                 // The JSON version identifier, e.g. "cdm-v1.0-v1.0"
+		Name        string                                 // The (human readable) name of the language
+		NewModel    func(reporter *generics.TReporter) any // Creating an empty model, as a pointer to the Go type of the models
+		Schema      []byte                                 // The JSON schema of the models (optional)
+		Validator   func(modelJSON []byte) []string        // Validating a model beyond its JSON schema, returning the findings (optional)
+		Converters  map[string]connect.TJSONMigration      // Converting models to other JSON versions, by JSON version (optional)
+	}
+)
+
+/*
+ * Registering languages
+ */
+
+var (
+	registeredLanguages      = map[string]TLanguage{} // The languages, by their JSON version
+	registeredLanguagesMutex sync.RWMutex             // Guarding the access to the languages
+)
+
+/*
+ *
+ * Externally visible functionality
+ *
+ */
+
+// Registering a language, including its JSON schema and converters
+func Register(language TLanguage) error {
+	// The JSON version identifies the language
+	if language.JSONVersion == "" {
+		return fmt.Errorf("language %s has no JSON version", language.Name)
+	}
+
+	// Register the JSON schema
+	if len(language.Schema) > 0 {
+		if err := connect.RegisterJSONSchema(language.JSONVersion, language.Schema); err != nil {
+			return err
+		}
+	}
+
+	// Register the converters
+	for toJSONVersion, converter := range language.Converters {
+		connect.RegisterJSONMigration(language.JSONVersion, toJSONVersion, converter)
+	}
+
+	// Register the language
+	registeredLanguagesMutex.Lock()
+	defer registeredLanguagesMutex.Unlock()
+
+	registeredLanguages[language.JSONVersion] = language
+
+	return nil
+}
+
+// Looking up the language for a JSON version
+func Lookup(jsonVersion string) (TLanguage, bool) {
+	registeredLanguagesMutex.RLock()
+	defer registeredLanguagesMutex.RUnlock()
+
+	language, registered := registeredLanguages[jsonVersion]
+
+	return language, registered
+}
+
+// Getting the JSON versions of the registered languages, in sorted order
+func JSONVersions() []string {
+	registeredLanguagesMutex.RLock()
+	defer registeredLanguagesMutex.RUnlock()
+
+	jsonVersions := []string{}
+	for jsonVersion := range registeredLanguages {
+		jsonVersions = append(jsonVersions, jsonVersion)
+	}
+	sort.Strings(jsonVersions)
+
+	return jsonVersions
+}
+
+// Finding the JSON version of a registered language among the path elements of a topic on the bus
+func JSONVersionOfTopic(topic string) (string, bool) {
+	for _, pathElement := range strings.Split(topic, "/") {
+		if _, registered := Lookup(pathElement); registered {
+			return pathElement, true
+		}
+	}
+
+	return "", false
+}
+
+// Decoding a model in the given JSON version, returning a pointer to the Go type of the models
+func Decode(jsonVersion string, modelJSON []byte, reporter *generics.TReporter) (any, error) {
+	// Find the language
+	language, registered := Lookup(jsonVersion)
+	if !registered {
+		return nil, fmt.Errorf("unknown JSON version %s", jsonVersion)
+	}
+	if language.NewModel == nil {
+		return nil, fmt.Errorf("language %s cannot create models", jsonVersion)
+	}
+
+	// Decode the model
+	model := language.NewModel(reporter)
+	if err := json.Unmarshal(modelJSON, model); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// Validating a model in the given JSON version against the JSON schema and validator of its language
+func Validate(jsonVersion string, modelJSON []byte) []string {
+	findings := []string{}
+
+	// Find the language
+	language, registered := Lookup(jsonVersion)
+	if !registered {
+		return append(findings, "unknown JSON version "+jsonVersion)
+	}
+
+	// Validate against the JSON schema
+	for _, validationError := range connect.ValidateJSON(jsonVersion, modelJSON) {
+		findings = append(findings, validationError.Path+": "+validationError.Message)
+	}
+
+	// Validate using the validator of the language
+	if language.Validator != nil && len(findings) == 0 {
+		findings = append(findings, language.Validator(modelJSON)...)
+	}
+
+	return findings
+}
+
+// Converting a model from one JSON version to another, using the registered converters
+func Convert(fromJSONVersion, toJSONVersion string, modelJSON []byte) ([]byte, error) {
+	return connect.MigrateJSON(fromJSONVersion, toJSONVersion, modelJSON)
+}